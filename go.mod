@@ -5,10 +5,14 @@ go 1.14
 require (
 	github.com/fatih/structs v1.1.0
 	github.com/gruntwork-io/terratest v0.28.8
-	github.com/hashicorp/terraform-plugin-sdk v1.10.0
+	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
+	github.com/hashicorp/terraform-plugin-sdk/v2 v2.0.0
 	github.com/mitchellh/mapstructure v1.1.2
 	github.com/sirupsen/logrus v1.4.2
 	github.com/stretchr/testify v1.4.0
 	github.com/terra-farm/udnssdk v1.3.5 // indirect
 	github.com/ultradns/ultradns-sdk-go v1.3.7
+	golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d
+	golang.org/x/sync v0.0.0-20200625203802-6e8e738ad208
+	golang.org/x/time v0.0.0-20191024005414-555d28b269f0
 )
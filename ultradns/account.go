@@ -0,0 +1,142 @@
+package ultradns
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ultradns/ultradns-sdk-go"
+)
+
+// account resolves the UltraDNS account account-level objects belong to.
+// name, usually a resource's account_name, takes precedence over the
+// provider's account_name. Without either, the credentials must have access
+// to exactly one account.
+func (c *Client) account(name string) (string, error) {
+	if name == "" {
+		name = c.AccountName
+	}
+
+	accounts, err := c.accounts()
+	if err != nil {
+		return "", err
+	}
+	names := make([]string, 0, len(accounts))
+	for _, a := range accounts {
+		names = append(names, a.AccountName)
+	}
+	sort.Strings(names)
+
+	if name == "" {
+		if len(names) == 1 {
+			return names[0], nil
+		}
+		return "", fmt.Errorf("credentials have access to %d accounts (%s), account_name must be set",
+			len(names), strings.Join(names, ", "))
+	}
+
+	var matches []string
+	for _, n := range names {
+		if n == name {
+			return n, nil
+		}
+		if strings.EqualFold(n, name) {
+			matches = append(matches, n)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("account %q not found, credentials have access to: %s", name, strings.Join(names, ", "))
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("account_name %q is ambiguous, it matches: %s", name, strings.Join(matches, ", "))
+	}
+}
+
+// suppressAccountNameCase hides differences in case between the configured
+// account_name and the spelling of the account in state. account accepts
+// either, and reads store the API's spelling.
+func suppressAccountNameCase(k, old, new string, d *schema.ResourceData) bool {
+	return old != "" && new != "" && strings.EqualFold(old, new)
+}
+
+// accounts returns the accounts the credentials can see. They are looked
+// up once per provider.
+func (c *Client) accounts() ([]udnssdk.Account, error) {
+	if c.accountCache == nil {
+		return c.selectAccounts()
+	}
+
+	c.accountCache.mu.Lock()
+	defer c.accountCache.mu.Unlock()
+	if c.accountCache.accounts == nil {
+		accounts, err := c.selectAccounts()
+		if err != nil {
+			return nil, err
+		}
+		c.accountCache.accounts = accounts
+	}
+	return c.accountCache.accounts, nil
+}
+
+func (c *Client) selectAccounts() ([]udnssdk.Account, error) {
+	accounts, _, err := c.Accounts.Select()
+	if err != nil {
+		return nil, fmt.Errorf("Error listing accounts: %s", err)
+	}
+	log.Printf("[DEBUG] Credentials have access to %d accounts", len(accounts))
+	return accounts, nil
+}
+
+// accountLevelGroups returns the names of the account-level geo and IP
+// groups referenced by geo_info and ip_info of the given rdata and
+// no_response blocks.
+func accountLevelGroups(blocks []interface{}) (geos []string, ips []string) {
+	for _, b := range blocks {
+		data := b.(map[string]interface{})
+		for _, g := range data["geo_info"].([]interface{}) {
+			if gi, ok := g.(map[string]interface{}); ok && gi["is_account_level"].(bool) {
+				geos = append(geos, gi["name"].(string))
+			}
+		}
+		for _, i := range data["ip_info"].([]interface{}) {
+			if ii, ok := i.(map[string]interface{}); ok && ii["is_account_level"].(bool) {
+				ips = append(ips, ii["name"].(string))
+			}
+		}
+	}
+	return geos, ips
+}
+
+// checkDirpoolAccountLevelGroups makes sure that the account-level groups
+// a dirpool refers to exist in its account, so that a typo or the wrong
+// account is reported by name instead of as a failed rollout.
+func checkDirpoolAccountLevelGroups(client *Client, d *schema.ResourceData) error {
+	blocks := d.Get("rdata").(*schema.Set).List()
+	blocks = append(blocks, d.Get("no_response").([]interface{})...)
+	geos, ips := accountLevelGroups(blocks)
+	if len(geos) == 0 && len(ips) == 0 {
+		return nil
+	}
+
+	account, err := client.account(d.Get("account_name").(string))
+	if err != nil {
+		return err
+	}
+	for _, name := range geos {
+		k := udnssdk.GeoDirectionalPoolKey{Account: udnssdk.AccountKey(account), Name: name}
+		if _, _, err := client.DirectionalPools.Geos().Find(k); err != nil {
+			return fmt.Errorf("account-level geo group %q not found in account %q: %v", name, account, err)
+		}
+	}
+	for _, name := range ips {
+		k := udnssdk.IPDirectionalPoolKey{Account: udnssdk.AccountKey(account), Name: name}
+		if _, _, err := client.DirectionalPools.IPs().Find(k); err != nil {
+			return fmt.Errorf("account-level IP group %q not found in account %q: %v", name, account, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package ultradns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	udnssdk "github.com/ultradns/ultradns-sdk-go"
+)
+
+// newAccountTestClient returns a client against a server whose credentials
+// see the given accounts.
+func newAccountTestClient(t *testing.T, names ...string) (*Client, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/accounts" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `[{"errorCode":70002,"errorMessage":"Not found"}]`)
+			return
+		}
+		accounts := make([]string, 0, len(names))
+		for _, n := range names {
+			accounts = append(accounts, fmt.Sprintf(`{"accountName":"%s"}`, n))
+		}
+		fmt.Fprintf(w, `{"accounts":[%s]}`, strings.Join(accounts, ","))
+	}))
+
+	client, err := udnssdk.NewClient("user", "pass", server.URL+"/")
+	assert.Nil(t, err, true)
+	client.HTTPClient = &http.Client{}
+	return &Client{Client: client}, server
+}
+
+func TestClientAccountSingle(t *testing.T) {
+	client, server := newAccountTestClient(t, "teamA")
+	defer server.Close()
+
+	account, err := client.account("")
+	assert.Nil(t, err, true)
+	assert.Equal(t, "teamA", account, true)
+}
+
+func TestClientAccountSeveral(t *testing.T) {
+	client, server := newAccountTestClient(t, "teamB", "teamA", "TEAMA")
+	defer server.Close()
+
+	_, err := client.account("")
+	assert.NotNil(t, err, true)
+	assert.Contains(t, err.Error(), "TEAMA, teamA, teamB", true)
+
+	account, err := client.account("teamB")
+	assert.Nil(t, err, true)
+	assert.Equal(t, "teamB", account, true)
+
+	account, err = client.account("TEAMB")
+	assert.Nil(t, err, true)
+	assert.Equal(t, "teamB", account, true)
+
+	_, err = client.account("Teama")
+	assert.NotNil(t, err, true)
+	assert.Contains(t, err.Error(), "ambiguous", true)
+
+	_, err = client.account("teamC")
+	assert.NotNil(t, err, true)
+	assert.Contains(t, err.Error(), "not found", true)
+
+	client.AccountName = "teamA"
+	account, err = client.account("")
+	assert.Nil(t, err, true)
+	assert.Equal(t, "teamA", account, true)
+}
+
+func TestAccountLevelGroups(t *testing.T) {
+	blocks := []interface{}{
+		map[string]interface{}{
+			"geo_info": []interface{}{
+				map[string]interface{}{"name": "Europe", "is_account_level": true},
+			},
+			"ip_info": []interface{}{
+				map[string]interface{}{"name": "office", "is_account_level": false},
+			},
+		},
+		map[string]interface{}{
+			"geo_info": []interface{}{},
+			"ip_info": []interface{}{
+				map[string]interface{}{"name": "datacenter", "is_account_level": true},
+			},
+		},
+	}
+
+	geos, ips := accountLevelGroups(blocks)
+	assert.Equal(t, []string{"Europe"}, geos, true)
+	assert.Equal(t, []string{"datacenter"}, ips, true)
+}
@@ -1,13 +1,15 @@
 package ultradns
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"strconv"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ultradns/ultradns-sdk-go"
 )
 
@@ -21,11 +23,17 @@ type rRSetResource struct {
 	Zone      string
 }
 
+// defaultTTL is used for resources that set no ttl when the provider sets
+// no default_ttl either
+const defaultTTL = 3600
+
 // profileAttrSchemaMap is a map from each ultradns_tcpool attribute name onto its respective ProfileSchema URI
 var profileAttrSchemaMap = map[string]udnssdk.ProfileSchema{
 	"dirpool_profile": udnssdk.DirPoolSchema,
 	"rdpool_profile":  udnssdk.RDPoolSchema,
 	"sbpool_profile":  udnssdk.SBPoolSchema,
+	"sfpool_profile":  sfPoolSchema,
+	"slbpool_profile": slbPoolSchema,
 	"tcpool_profile":  udnssdk.TCPoolSchema,
 }
 
@@ -157,8 +165,8 @@ func mapFromLimit(name string, l udnssdk.ProbeDetailsLimitDTO) map[string]interf
 // hashLimits generates a hashcode for a limits block
 func hashLimits(v interface{}) int {
 	m := v.(map[string]interface{})
-	h := hashcode.String(m["name"].(string))
-	log.Printf("[INFO] hashLimits(): %v -> %v", m["name"].(string), h)
+	h := schema.HashString(m["name"].(string))
+	log.Printf("[DEBUG] hashLimits(): %v -> %v", m["name"].(string), h)
 	return h
 }
 
@@ -194,11 +202,39 @@ func makeSetFromStrings(ss []string) *schema.Set {
 // hashRdata generates a hashcode for an Rdata block
 func hashRdatas(v interface{}) int {
 	m := v.(map[string]interface{})
-	h := hashcode.String(m["host"].(string))
+	h := schema.HashString(m["host"].(string))
 	log.Printf("[DEBUG] hashRdatas(): %v -> %v", m["host"].(string), h)
 	return h
 }
 
+// createdProbeID returns the ID of probe p, created by the request that
+// was answered with resp. The API names the new probe in the Location
+// header; when that is missing, e.g. for a create that ran as a task, the
+// probe is looked up among those of its RRSet instead.
+func createdProbeID(client *Client, resp *http.Response, p probeResource) (string, error) {
+	if resp != nil {
+		parts := strings.Split(resp.Header.Get("Location"), "probes/")
+		if len(parts) == 2 && parts[1] != "" {
+			return parts[1], nil
+		}
+	}
+
+	probes, _, err := client.Probes.Select(p.RRSetKey(), "")
+	if err != nil {
+		return "", fmt.Errorf("probe created, but not found: %v", err)
+	}
+	ids := []string{}
+	for _, probe := range probes {
+		if probe.ProbeType == p.Type && probe.PoolRecord == p.PoolRecord {
+			ids = append(ids, probe.ID)
+		}
+	}
+	if len(ids) != 1 {
+		return "", fmt.Errorf("probe created, but found %d %s probes for pool record %q", len(ids), p.Type, p.PoolRecord)
+	}
+	return ids[0], nil
+}
+
 func setProbeResourceAndParseId(d *schema.ResourceData) (resourceData []*schema.ResourceData, err error) {
 	newID := strings.TrimSuffix(d.Id(), ".")
 	attributes := strings.Split(newID, ":")
@@ -222,3 +258,97 @@ func setResourceAndParseId(d *schema.ResourceData) (resourceData []*schema.Resou
 	d.Set("name", attributes[0])
 	return []*schema.ResourceData{d}, nil
 }
+
+func setZoneResourceAndParseId(d *schema.ResourceData) (resourceData []*schema.ResourceData, err error) {
+	return setZoneAttributeAndParseId(d, "name")
+}
+
+// setZoneAttributeAndParseId takes a zone name from the ID and sets it in
+// attr, for resources that keep the zone under another attribute than name.
+func setZoneAttributeAndParseId(d *schema.ResourceData, attr string) (resourceData []*schema.ResourceData, err error) {
+	if d.Id() == "" || strings.ContainsAny(d.Id(), ": ") {
+		return nil, fmt.Errorf("Wrong ID please provide proper ID in format %s", attr)
+	}
+	d.Set(attr, d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+// setDirGroupResourceAndParseId takes an account-level group from an ID in
+// the format name, for a group in the provider's account, or account:name.
+func setDirGroupResourceAndParseId(d *schema.ResourceData) (resourceData []*schema.ResourceData, err error) {
+	account, name := "", d.Id()
+	if i := strings.Index(name, ":"); i >= 0 {
+		account, name = name[:i], name[i+1:]
+		if strings.TrimSpace(account) == "" {
+			return nil, errors.New("Wrong ID please provide proper ID in format name or account:name")
+		}
+	}
+	if strings.TrimSpace(name) == "" || strings.Contains(name, "/") {
+		return nil, errors.New("Wrong ID please provide proper ID in format name or account:name")
+	}
+	if account != "" {
+		d.Set("account_name", account)
+	}
+	d.Set("name", name)
+	d.SetId(name)
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseZoneGUIDId splits the ID of an object UltraDNS identifies by a GUID
+// within a zone, such as a web or mail forward, into zone and guid
+func parseZoneGUIDId(id string) (zone, guid string, err error) {
+	attributes := strings.Split(id, ":")
+	if len(attributes) != 2 || attributes[0] == "" || attributes[1] == "" {
+		return "", "", errors.New("Wrong ID please provide proper ID in format zone:guid")
+	}
+	return attributes[0], attributes[1], nil
+}
+
+// customizeDiffProviderDefaults fills zone, ttl and description from the
+// provider-level defaults when the configuration leaves them out, so that
+// plans show the values that will be used. attrs lists which of them the
+// resource has.
+//
+// Only values that are neither configured nor in state are filled, so an
+// explicit zero such as ttl = 0 is kept, and changing a default leaves
+// existing resources alone. GetOkExists tells a configured zero apart from
+// an unset attribute, which is computed until filled here.
+func customizeDiffProviderDefaults(attrs ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		client := meta.(*Client)
+		for _, attr := range attrs {
+			if _, ok := d.GetOkExists(attr); ok {
+				continue
+			}
+
+			var value interface{}
+			switch attr {
+			case "zone":
+				if client.DefaultZone == "" {
+					return fmt.Errorf("zone must be set, or default_zone configured on the provider")
+				}
+				value = client.DefaultZone
+			case "ttl":
+				ttl := client.DefaultTTL
+				if ttl == 0 {
+					ttl = defaultTTL
+				}
+				value = ttl
+				if _, ok := d.Get(attr).(string); ok {
+					value = strconv.Itoa(ttl)
+				}
+			case "description":
+				if client.DefaultDescription == "" {
+					continue
+				}
+				value = client.DefaultDescription
+			}
+
+			log.Printf("[DEBUG] %s not configured, using provider default: %v", attr, value)
+			if err := d.SetNew(attr, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
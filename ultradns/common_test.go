@@ -1,12 +1,13 @@
 package ultradns
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	udnssdk "github.com/ultradns/ultradns-sdk-go"
@@ -153,7 +154,7 @@ func TestHashRdatas(t *testing.T) {
 }
 
 func testAccRdpoolCheckDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*udnssdk.Client)
+	client := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ultradns_rdpool" {
@@ -176,7 +177,7 @@ func testAccRdpoolCheckDestroy(s *terraform.State) error {
 }
 
 func testAccTcpoolCheckDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*udnssdk.Client)
+	client := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ultradns_tcpool" {
@@ -210,7 +211,7 @@ func testAccCheckUltradnsRecordExists(n string, record *udnssdk.RRSet) resource.
 			return fmt.Errorf("No Record ID is set")
 		}
 
-		client := testAccProvider.Meta().(*udnssdk.Client)
+		client := testAccProvider.Meta().(*Client)
 		k := udnssdk.RRSetKey{
 			Zone: rs.Primary.Attributes["zone"],
 			Name: rs.Primary.Attributes["name"],
@@ -232,3 +233,92 @@ func testAccCheckUltradnsRecordExists(n string, record *udnssdk.RRSet) resource.
 		return nil
 	}
 }
+
+func TestCustomizeDiffProviderDefaults(t *testing.T) {
+	client := &Client{
+		DefaultZone:        "example.com.",
+		DefaultTTL:         300,
+		DefaultDescription: "managed by terraform",
+	}
+	r := resourceUltradnsTcpool()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "pool",
+		"rdata": []interface{}{
+			map[string]interface{}{"host": "10.0.0.1"},
+		},
+	})
+	diff, err := r.Diff(context.Background(), nil, config, client)
+	assert.Nil(t, err, true)
+	assert.Equal(t, "example.com.", diff.Attributes["zone"].New, true)
+	assert.Equal(t, "300", diff.Attributes["ttl"].New, true)
+	assert.Equal(t, "managed by terraform", diff.Attributes["description"].New, true)
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"zone":        "other.com.",
+		"name":        "pool",
+		"ttl":         60,
+		"description": "pool",
+		"rdata": []interface{}{
+			map[string]interface{}{"host": "10.0.0.1"},
+		},
+	})
+	diff, err = r.Diff(context.Background(), nil, config, client)
+	assert.Nil(t, err, true)
+	assert.Equal(t, "other.com.", diff.Attributes["zone"].New, true)
+	assert.Equal(t, "60", diff.Attributes["ttl"].New, true)
+	assert.Equal(t, "pool", diff.Attributes["description"].New, true)
+
+	record := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":  "www",
+		"type":  "A",
+		"rdata": []interface{}{"10.0.0.1"},
+	})
+	diff, err = resourceUltradnsRecord().Diff(context.Background(), nil, record, &Client{DefaultZone: "example.com."})
+	assert.Nil(t, err, true)
+	assert.Equal(t, "3600", diff.Attributes["ttl"].New, true)
+
+	// An explicit zero is not replaced by the default
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":        "pool",
+		"ttl":         0,
+		"description": "",
+		"rdata": []interface{}{
+			map[string]interface{}{"host": "10.0.0.1"},
+		},
+	})
+	diff, err = r.Diff(context.Background(), nil, config, client)
+	assert.Nil(t, err, true)
+	assert.Equal(t, "0", diff.Attributes["ttl"].New, true)
+	assert.Equal(t, "example.com.", diff.Attributes["zone"].New, true)
+
+	// Nor are values in state, when the default changes
+	state := &terraform.InstanceState{
+		ID: "pool:example.com.",
+		Attributes: map[string]string{
+			"zone":        "example.com.",
+			"name":        "pool",
+			"ttl":         "300",
+			"description": "managed by terraform",
+		},
+	}
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "pool",
+		"rdata": []interface{}{
+			map[string]interface{}{"host": "10.0.0.1"},
+		},
+	})
+	changed := &Client{DefaultZone: "example.com.", DefaultTTL: 60, DefaultDescription: "changed"}
+	diff, err = r.Diff(context.Background(), state, config, changed)
+	assert.Nil(t, err, true)
+	if diff != nil {
+		_, ok := diff.Attributes["ttl"]
+		assert.False(t, ok, true)
+		_, ok = diff.Attributes["description"]
+		assert.False(t, ok, true)
+	}
+
+	_, err = resourceUltradnsRecord().Diff(context.Background(), nil, record, &Client{})
+	assert.NotNil(t, err, true)
+	assert.Contains(t, err.Error(), "default_zone", true)
+}
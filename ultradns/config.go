@@ -1,28 +1,230 @@
 package ultradns
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
 
 	"github.com/ultradns/ultradns-sdk-go"
+	"golang.org/x/oauth2"
 )
 
 // Config collects the connection service-endpoint and credentials
 type Config struct {
-	Username string
-	Password string
-	BaseURL  string
+	Username     string
+	Password     string
+	AccessToken  string
+	RefreshToken string
+	BaseURL      string
+
+	MaxRetries   int
+	RetryMinWait time.Duration
+	RetryMaxWait time.Duration
+
+	CABundleFile       string
+	InsecureSkipVerify bool
+	ClientCertFile     string
+	ClientKeyFile      string
+	HTTPProxy          string
+	RequestTimeout     time.Duration
+
+	DefaultZone        string
+	DefaultTTL         int
+	DefaultDescription string
+
+	AccountName string
+
+	RequestsPerSecond     float64
+	MaxConcurrentRequests int
+}
+
+// Client is the meta value handed to every resource: the UltraDNS API
+// client together with the provider-level defaults.
+type Client struct {
+	*udnssdk.Client
+
+	DefaultZone        string
+	DefaultTTL         int
+	DefaultDescription string
+
+	// AccountName is the provider's account_name
+	AccountName string
+
+	// accountCache is shared by all copies made by withContext
+	accountCache *accountCache
+}
+
+type accountCache struct {
+	mu       sync.Mutex
+	accounts []udnssdk.Account
 }
 
 // Client returns a new client for accessing UltraDNS.
-func (c *Config) Client() (*udnssdk.Client, error) {
+func (c *Config) Client() (*Client, error) {
 	client, err := udnssdk.NewClient(c.Username, c.Password, c.BaseURL)
 
 	if err != nil {
 		return nil, fmt.Errorf("Error setting up client: %s", err)
 	}
 
+	base, err := c.baseTransport()
+	if err != nil {
+		return nil, err
+	}
+
+	// All requests of a provider, token requests included, share one
+	// rate limit
+	limited := newRateLimitTransport(&loggingTransport{base: base}, c.RequestsPerSecond, c.MaxConcurrentRequests)
+
+	// Token requests go through the same TLS and proxy settings as the API
+	tokenClient := &http.Client{
+		Transport: limited,
+		Timeout:   c.RequestTimeout,
+	}
+
+	var transport http.RoundTripper = &retryTransport{
+		base:       limited,
+		maxRetries: c.MaxRetries,
+		minWait:    c.RetryMinWait,
+		maxWait:    c.RetryMaxWait,
+	}
+	transport = &taskTransport{base: transport}
+
+	if c.AccessToken != "" || c.RefreshToken != "" {
+		// Tokens take precedence over the password flow the SDK sets up
+		source := newTokenSource(c.AccessToken, c.RefreshToken, c.BaseURL)
+		source.httpClient = tokenClient
+		client.HTTPClient = &http.Client{
+			Transport: &tokenTransport{
+				source: source,
+				base:   transport,
+			},
+			Timeout: c.RequestTimeout,
+		}
+		log.Printf("[INFO] UltraDNS Client configured with access token")
+		return c.wrap(client), nil
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, tokenClient)
+	client.HTTPClient = &http.Client{
+		Transport: &oauth2.Transport{
+			Source: client.Config.TokenSource(ctx),
+			Base:   transport,
+		},
+		Timeout: c.RequestTimeout,
+	}
 	log.Printf("[INFO] UltraDNS Client configured for user: %s", c.Username)
 
-	return client, nil
+	return c.wrap(client), nil
+}
+
+func (c *Config) wrap(client *udnssdk.Client) *Client {
+	return &Client{
+		Client:             client,
+		DefaultZone:        c.DefaultZone,
+		DefaultTTL:         c.DefaultTTL,
+		DefaultDescription: c.DefaultDescription,
+		AccountName:        c.AccountName,
+		accountCache:       &accountCache{},
+	}
+}
+
+// withContext returns a copy of the client whose API calls are bound to
+// ctx, so that cancelling ctx aborts requests in flight. udnssdk builds
+// its requests without a context, hence a fresh set of services on top of
+// a transport that attaches it. Clients without HTTP, as used by unit
+// tests, are returned unchanged.
+func (c *Client) withContext(ctx context.Context) *Client {
+	if c.HTTPClient == nil || c.BaseURL == nil {
+		return c
+	}
+
+	bound, err := udnssdk.NewClient("", "", c.BaseURL.String())
+	if err != nil {
+		return c
+	}
+	bound.Config = c.Config
+	bound.UserAgent = c.UserAgent
+	bound.HTTPClient = &http.Client{
+		Transport: &contextTransport{ctx: ctx, client: c.HTTPClient},
+	}
+
+	bc := *c
+	bc.Client = bound
+	return &bc
+}
+
+// contextTransport sends requests through client with ctx attached.
+type contextTransport struct {
+	ctx    context.Context
+	client *http.Client
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.client.Do(req.WithContext(t.ctx))
+	if uerr, ok := err.(*url.Error); ok {
+		// The outer client adds method and URL itself
+		err = uerr.Err
+	}
+	return resp, err
+}
+
+// baseTransport returns the transport that talks to the network, set up
+// with the configured TLS trust, client certificate and proxy.
+func (c *Config) baseTransport() (http.RoundTripper, error) {
+	if c.CABundleFile == "" && !c.InsecureSkipVerify &&
+		c.ClientCertFile == "" && c.ClientKeyFile == "" && c.HTTPProxy == "" {
+		return http.DefaultTransport, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+
+	if c.CABundleFile != "" {
+		pem, err := ioutil.ReadFile(c.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading ca_bundle_file: %s", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("Error reading ca_bundle_file: no PEM certificates found in %s", c.CABundleFile)
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if c.ClientCertFile != "" || c.ClientKeyFile != "" {
+		if c.ClientCertFile == "" || c.ClientKeyFile == "" {
+			return nil, fmt.Errorf("client_cert_file and client_key_file must be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(c.ClientCertFile, c.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading client certificate: %s", err)
+		}
+		transport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if c.HTTPProxy != "" {
+		proxy, err := url.Parse(c.HTTPProxy)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing http_proxy: %s", err)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	if c.InsecureSkipVerify {
+		log.Printf("[WARN] UltraDNS Client does not verify the server certificate")
+	}
+	return transport, nil
 }
@@ -0,0 +1,98 @@
+package ultradns
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	udnssdk "github.com/ultradns/ultradns-sdk-go"
+)
+
+func TestConfigBaseTransportDefault(t *testing.T) {
+	config := Config{}
+	transport, err := config.baseTransport()
+	assert.Nil(t, err, true)
+	assert.Equal(t, http.DefaultTransport, transport, true)
+}
+
+func TestConfigBaseTransportCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "ultradns")
+	assert.Nil(t, err, true)
+	defer os.RemoveAll(dir)
+
+	bundle := filepath.Join(dir, "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	assert.Nil(t, ioutil.WriteFile(bundle, certPEM, 0600), true)
+
+	_, err = (&http.Client{Transport: http.DefaultTransport}).Get(server.URL)
+	assert.NotNil(t, err, true)
+
+	config := Config{CABundleFile: bundle}
+	transport, err := config.baseTransport()
+	assert.Nil(t, err, true)
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	assert.Nil(t, err, true)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, true)
+
+	config = Config{InsecureSkipVerify: true}
+	transport, err = config.baseTransport()
+	assert.Nil(t, err, true)
+	resp, err = (&http.Client{Transport: transport}).Get(server.URL)
+	assert.Nil(t, err, true)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, true)
+
+	config = Config{CABundleFile: filepath.Join(dir, "missing.pem")}
+	_, err = config.baseTransport()
+	assert.NotNil(t, err, true)
+}
+
+func TestConfigBaseTransportProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+	}))
+	defer proxy.Close()
+
+	config := Config{HTTPProxy: proxy.URL}
+	transport, err := config.baseTransport()
+	assert.Nil(t, err, true)
+
+	resp, err := (&http.Client{Transport: transport}).Get("http://restapi.ultradns.invalid/v2/zones")
+	assert.Nil(t, err, true)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, true)
+	assert.Equal(t, "http://restapi.ultradns.invalid/v2/zones", proxied, true)
+}
+
+func TestConfigBaseTransportClientCertRequiresKey(t *testing.T) {
+	config := Config{ClientCertFile: "cert.pem"}
+	_, err := config.baseTransport()
+	assert.NotNil(t, err, true)
+	assert.Contains(t, err.Error(), "must be set together", true)
+}
+
+func TestClientWithContextCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	sdk, err := udnssdk.NewClient("", "", server.URL)
+	assert.Nil(t, err, true)
+	sdk.HTTPClient = server.Client()
+	client := &Client{Client: sdk}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.withContext(ctx).RRSets.Select(udnssdk.RRSetKey{Zone: "example.com", Name: "www", Type: "A"})
+	assert.True(t, errors.Is(err, context.Canceled), true)
+}
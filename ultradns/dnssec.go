@@ -0,0 +1,69 @@
+package ultradns
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// udnssdk has no DNSSEC support, so the dnssec endpoints of a zone are
+// called through the client's Do with the DTOs below.
+
+// zoneDNSSECDTO reports whether a zone is signed, how nonexistent names
+// are proven and with which keys.
+type zoneDNSSECDTO struct {
+	Status   string         `json:"status,omitempty"`
+	NSECType string         `json:"nsecType,omitempty"`
+	Keys     []dnssecKeyDTO `json:"keys,omitempty"`
+}
+
+// dnssecKeyDTO is a key signing (KSK) or zone signing (ZSK) key of a
+// signed zone. Only key signing keys have DS records, which are published
+// in the parent zone.
+type dnssecKeyDTO struct {
+	Type         string   `json:"type"`
+	KeyTag       int      `json:"keyTag"`
+	Algorithm    int      `json:"algorithm"`
+	DNSKEYRecord string   `json:"dnskeyRecord"`
+	DSRecords    []string `json:"dsRecords,omitempty"`
+}
+
+// dnssecRolloverDTO asks for new keys of KeyType, KSK, ZSK or ALL.
+type dnssecRolloverDTO struct {
+	KeyType string `json:"keyType"`
+}
+
+// zoneDNSSECURI returns the URI of the DNSSEC configuration of zone.
+func zoneDNSSECURI(zone string) string {
+	return fmt.Sprintf("%s/dnssec", zoneURI(zone))
+}
+
+// getZoneDNSSEC returns the DNSSEC configuration of zone.
+func (c *Client) getZoneDNSSEC(zone string) (zoneDNSSECDTO, error) {
+	var s zoneDNSSECDTO
+	_, err := c.Do("GET", zoneDNSSECURI(zone), nil, &s)
+	return s, err
+}
+
+// signZone signs zone, proving nonexistent names with nsecType.
+func (c *Client) signZone(zone, nsecType string) (*http.Response, error) {
+	var ignored interface{}
+	return c.Do("POST", zoneDNSSECURI(zone), zoneDNSSECDTO{NSECType: nsecType}, &ignored)
+}
+
+// updateZoneDNSSEC changes how nonexistent names of the signed zone are
+// proven.
+func (c *Client) updateZoneDNSSEC(zone, nsecType string) (*http.Response, error) {
+	var ignored interface{}
+	return c.Do("PUT", zoneDNSSECURI(zone), zoneDNSSECDTO{NSECType: nsecType}, &ignored)
+}
+
+// rolloverZoneKeys replaces the keys of keyType of the signed zone.
+func (c *Client) rolloverZoneKeys(zone, keyType string) (*http.Response, error) {
+	var ignored interface{}
+	return c.Do("POST", zoneDNSSECURI(zone)+"/rollover", dnssecRolloverDTO{KeyType: keyType}, &ignored)
+}
+
+// unsignZone removes the signatures and keys of zone.
+func (c *Client) unsignZone(zone string) (*http.Response, error) {
+	return c.Do("DELETE", zoneDNSSECURI(zone), nil, nil)
+}
@@ -0,0 +1,1340 @@
+package ultradns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	udnssdk "github.com/ultradns/ultradns-sdk-go"
+)
+
+const (
+	fakeUsername = "terraform"
+	fakePassword = "secret"
+	fakeAccount  = "terraform-account"
+)
+
+// fakeUltraDNS is an in-process stand-in for the UltraDNS REST API. It
+// implements the authorization, zones, rrsets (profiles included), probes,
+// account-level directional groups and tasks endpoints closely enough for resources to run their whole
+// lifecycle in unit tests, and answers with the error codes the live API
+// uses, e.g. 70002 for data that does not exist.
+type fakeUltraDNS struct {
+	*httptest.Server
+
+	// async makes writes start a background task, answered with 202 and
+	// X-Task-Id, instead of completing in the request
+	async bool
+	// noLocation leaves the Location header out of answers to creates
+	noLocation bool
+	// noCreatedBody leaves the created web or mail forward out of the
+	// answer to its create
+	noCreatedBody bool
+
+	mu            sync.Mutex
+	accessTokens  map[string]bool
+	refreshTokens map[string]bool
+	zones         map[string]*fakeZone
+	probes        map[string]*fakeProbe
+	dirGroups     map[fakeDirGroupKey]map[string]interface{}
+	tasks         map[string]udnssdk.Task
+	nextID        int
+}
+
+type fakeZone struct {
+	zone         zoneDTO
+	rrsets       map[fakeRRSetKey]udnssdk.RRSet
+	webForwards  map[string]webForwardDTO
+	mailForwards map[string]mailForwardDTO
+	// dnssec is nil while the zone is unsigned
+	dnssec *zoneDNSSECDTO
+}
+
+type fakeRRSetKey struct {
+	rrtype string
+	owner  string
+}
+
+// fakeError is an error as the API reports it
+type fakeError struct {
+	ErrorCode        int    `json:"errorCode"`
+	ErrorMessage     string `json:"errorMessage"`
+	Error            string `json:"error,omitempty"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// fakeDirGroupKey identifies an account-level geo or IP group
+type fakeDirGroupKey struct {
+	account   string
+	grouptype string
+	name      string
+}
+
+type fakeProbe struct {
+	zone  string
+	rrset fakeRRSetKey
+	probe map[string]interface{}
+}
+
+// rrtypeCodes maps record types onto the numeric codes the API appends to
+// them, as in "A (1)"
+var rrtypeCodes = map[string]int{
+	"A":     1,
+	"NS":    2,
+	"CNAME": 5,
+	"SOA":   6,
+	"PTR":   12,
+	"MX":    15,
+	"TXT":   16,
+	"AAAA":  28,
+	"SRV":   33,
+	"SPF":   99,
+	"CAA":   257,
+}
+
+// newFakeUltraDNS starts a fake API serving the given primary zones. It is
+// shut down when the test finishes.
+func newFakeUltraDNS(t *testing.T, zones ...string) *fakeUltraDNS {
+	f := &fakeUltraDNS{
+		accessTokens:  map[string]bool{},
+		refreshTokens: map[string]bool{},
+		zones:         map[string]*fakeZone{},
+		probes:        map[string]*fakeProbe{},
+		dirGroups:     map[fakeDirGroupKey]map[string]interface{}{},
+		tasks:         map[string]udnssdk.Task{},
+	}
+	for _, z := range zones {
+		f.addZone(z)
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	t.Cleanup(f.Close)
+	return f
+}
+
+// client returns a provider client for the fake, configured the way
+// providerConfigure would for username and password.
+func (f *fakeUltraDNS) client(t *testing.T) *Client {
+	config := Config{
+		Username: fakeUsername,
+		Password: fakePassword,
+		BaseURL:  f.URL + "/",
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("client for fake UltraDNS: %v", err)
+	}
+	return client
+}
+
+// addZone adds a new primary zone.
+func (f *fakeUltraDNS) addZone(name string) *fakeZone {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.newZone(zoneDTO{Properties: zoneProperties{Name: name, Type: "PRIMARY"}})
+}
+
+// newZone stores z, filling in what the API computes. Primary zones get
+// the SOA and NS records UltraDNS creates for them.
+func (f *fakeUltraDNS) newZone(zone zoneDTO) *fakeZone {
+	zone.PrimaryCreateInfo = nil
+	if info := zone.SecondaryCreateInfo; info != nil {
+		zone.PrimaryNameServers = &info.PrimaryNameServers
+		zone.NotificationEmailAddress = info.NotificationEmailAddress
+		zone.TransferStatusDetails = fakeTransferStatus()
+		zone.SecondaryCreateInfo = nil
+	}
+	if info := zone.AliasCreateInfo; info != nil {
+		zone.OriginalZoneName = fakeZoneName(info.OriginalZoneName)
+		zone.AliasCreateInfo = nil
+	}
+	zone.Properties.Name = fakeZoneName(zone.Properties.Name)
+	if zone.Properties.AccountName == "" {
+		zone.Properties.AccountName = fakeAccount
+	}
+	zone.Properties.Status = "ACTIVE"
+	zone.Properties.Owner = fakeUsername
+	zone.Properties.DNSSECStatus = "UNSIGNED"
+
+	z := &fakeZone{zone: zone, rrsets: map[fakeRRSetKey]udnssdk.RRSet{}}
+	if zone.Properties.Type == "PRIMARY" {
+		z.rrsets[fakeKey(z, "SOA", "")] = udnssdk.RRSet{
+			TTL:   86400,
+			RData: []string{"udns1.ultradns.net. hostmaster.example.com. 2020070101 86400 86400 86400 86400"},
+		}
+		z.rrsets[fakeKey(z, "NS", "")] = udnssdk.RRSet{
+			TTL:   86400,
+			RData: fakeNameservers,
+		}
+	}
+	z.touch()
+	f.zones[zone.Properties.Name] = z
+	return z
+}
+
+// fakeNameservers are the nameservers assigned to new primary zones
+var fakeNameservers = []string{"udns1.ultradns.net.", "udns2.ultradns.net."}
+
+// fakeTransferStatus returns the status of a transfer that just succeeded.
+func fakeTransferStatus() *transferStatusDetails {
+	now := time.Now().UTC()
+	return &transferStatusDetails{
+		LastRefresh:       now.Format("2006-01-02T15:04Z"),
+		NextRefresh:       now.Add(time.Hour).Format("2006-01-02T15:04Z"),
+		LastRefreshStatus: "SUCCESSFUL",
+	}
+}
+
+// touch records that z has been modified.
+func (z *fakeZone) touch() {
+	z.zone.Properties.LastModifiedDateTime = time.Now().UTC().Format("2006-01-02T15:04Z")
+}
+
+// addRRSet stores rrset in zone, which must exist.
+func (f *fakeUltraDNS) addRRSet(zone string, rrset udnssdk.RRSet) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	z := f.zones[fakeZoneName(zone)]
+	z.rrsets[fakeKey(z, rrset.RRType, rrset.OwnerName)] = rrset
+}
+
+// rrset returns a stored rrset.
+func (f *fakeUltraDNS) rrset(zone, rrtype, owner string) (udnssdk.RRSet, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	z, ok := f.zones[fakeZoneName(zone)]
+	if !ok {
+		return udnssdk.RRSet{}, false
+	}
+	rrset, ok := z.rrsets[fakeKey(z, rrtype, owner)]
+	return rrset, ok
+}
+
+// fakeZoneName canonicalizes a zone name the way the API does.
+func fakeZoneName(name string) string {
+	name = strings.ToLower(name)
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+	return name
+}
+
+// fakeKey builds the key of an rrset from the type and owner name found in
+// a request. Relative owner names are qualified with the zone.
+func fakeKey(z *fakeZone, rrtype, owner string) fakeRRSetKey {
+	rrtype = strings.ToUpper(strings.Split(rrtype, " ")[0])
+	owner = strings.ToLower(owner)
+	switch {
+	case owner == "" || owner == "@":
+		owner = z.zone.Properties.Name
+	case !strings.HasSuffix(owner, "."):
+		owner = owner + "." + z.zone.Properties.Name
+	}
+	return fakeRRSetKey{rrtype: rrtype, owner: owner}
+}
+
+func (f *fakeUltraDNS) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := fakePath(r.URL)
+	if len(path) == 2 && path[0] == "authorization" && path[1] == "token" {
+		f.serveToken(w, r)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.accessTokens[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")] {
+		writeFakeJSON(w, http.StatusUnauthorized, fakeError{
+			ErrorCode:    60001,
+			ErrorMessage: "invalid_grant:token not found, expired or invalid",
+		})
+		return
+	}
+
+	switch {
+	case len(path) == 2 && path[0] == "tasks":
+		f.serveTask(w, r, path[1])
+	case len(path) == 1 && path[0] == "accounts":
+		f.serveAccounts(w, r)
+	case len(path) == 5 && path[0] == "accounts" && path[2] == "dirgroups" && (path[3] == "geo" || path[3] == "ip"):
+		if path[1] != fakeAccount {
+			writeFakeErrors(w, http.StatusNotFound, 70002, "Data not found.")
+			return
+		}
+		f.serveDirGroup(w, r, fakeDirGroupKey{account: path[1], grouptype: path[3], name: path[4]})
+	case len(path) == 1 && path[0] == "zones":
+		f.serveZones(w, r)
+	case len(path) >= 2 && path[0] == "zones":
+		z, ok := f.zones[fakeZoneName(path[1])]
+		if !ok {
+			writeFakeErrors(w, http.StatusNotFound, 1801, "Zone does not exist in the system.")
+			return
+		}
+		switch {
+		case len(path) == 2:
+			f.serveZone(w, r, z)
+		case path[2] == "webforwards" && len(path) == 3:
+			f.serveWebForwards(w, r, z)
+		case path[2] == "webforwards" && len(path) == 4:
+			f.serveWebForward(w, r, z, path[3])
+		case path[2] == "mailforwards" && len(path) == 3:
+			f.serveMailForwards(w, r, z)
+		case path[2] == "mailforwards" && len(path) == 4:
+			f.serveMailForward(w, r, z, path[3])
+		case path[2] == "dnssec" && len(path) == 3:
+			f.serveDNSSEC(w, r, z)
+		case path[2] == "dnssec" && len(path) == 4 && path[3] == "rollover":
+			f.serveDNSSECRollover(w, r, z)
+		case path[2] != "rrsets" || len(path) > 7 || (len(path) >= 6 && path[5] != "probes"):
+			writeFakeErrors(w, http.StatusNotFound, 404, "Not Found")
+		case len(path) <= 4:
+			rrtype := ""
+			if len(path) == 4 {
+				rrtype = path[3]
+			}
+			f.serveRRSets(w, r, z, rrtype, "")
+		case len(path) == 5:
+			f.serveRRSet(w, r, z, fakeKey(z, path[3], path[4]))
+		case len(path) == 6:
+			f.serveProbes(w, r, z, fakeKey(z, path[3], path[4]))
+		default:
+			f.serveProbe(w, r, z, fakeKey(z, path[3], path[4]), path[6])
+		}
+	default:
+		writeFakeErrors(w, http.StatusNotFound, 404, "Not Found")
+	}
+}
+
+// fakePath splits the path of a request into unescaped segments. Empty
+// segments and a leading version, as in "/v2/zones", are dropped.
+func fakePath(u *url.URL) []string {
+	var path []string
+	for _, s := range strings.Split(u.EscapedPath(), "/") {
+		if s == "" {
+			continue
+		}
+		if unescaped, err := url.PathUnescape(s); err == nil {
+			s = unescaped
+		}
+		path = append(path, s)
+	}
+	if len(path) > 0 && (path[0] == "v1" || path[0] == "v2") {
+		path = path[1:]
+	}
+	return path
+}
+
+func (f *fakeUltraDNS) serveToken(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Form.Get("grant_type") {
+	case "password":
+		if r.Form.Get("username") != fakeUsername || r.Form.Get("password") != fakePassword {
+			writeFakeJSON(w, http.StatusBadRequest, fakeError{
+				ErrorCode:        60001,
+				ErrorMessage:     "invalid_grant:Invalid username & password combination.",
+				Error:            "invalid_grant",
+				ErrorDescription: "60001: invalid_grant:Invalid username & password combination.",
+			})
+			return
+		}
+	case "refresh_token":
+		if !f.refreshTokens[r.Form.Get("refresh_token")] {
+			writeFakeJSON(w, http.StatusBadRequest, fakeError{
+				ErrorCode:        60001,
+				ErrorMessage:     "invalid_grant:Invalid or expired refresh token.",
+				Error:            "invalid_grant",
+				ErrorDescription: "60001: invalid_grant:Invalid or expired refresh token.",
+			})
+			return
+		}
+	default:
+		writeFakeJSON(w, http.StatusBadRequest, fakeError{
+			ErrorCode:    60001,
+			ErrorMessage: "unsupported_grant_type",
+			Error:        "unsupported_grant_type",
+		})
+		return
+	}
+
+	access, refresh := f.newID(), f.newID()
+	f.accessTokens[access] = true
+	f.refreshTokens[refresh] = true
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{
+		"tokenType":     "Bearer",
+		"accessToken":   access,
+		"refreshToken":  refresh,
+		"expiresIn":     "3600",
+		"token_type":    "Bearer",
+		"access_token":  access,
+		"refresh_token": refresh,
+		"expires_in":    3600,
+	})
+}
+
+func (f *fakeUltraDNS) serveTask(w http.ResponseWriter, r *http.Request, id string) {
+	task, ok := f.tasks[id]
+	if !ok {
+		writeFakeErrors(w, http.StatusNotFound, 70002, "Data not found.")
+		return
+	}
+	writeFakeJSON(w, http.StatusOK, task)
+}
+
+func (f *fakeUltraDNS) serveZones(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		var name, account string
+		for _, term := range strings.Fields(r.URL.Query().Get("q")) {
+			kv := strings.SplitN(term, ":", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			switch kv[0] {
+			case "name":
+				name = fakeZoneName(kv[1])
+			case "account_name":
+				account = kv[1]
+			}
+		}
+		zones := []zoneDTO{}
+		for _, z := range f.zones {
+			if (name == "" || z.zone.Properties.Name == name) &&
+				(account == "" || z.zone.Properties.AccountName == account) {
+				zones = append(zones, f.zoneDTO(z))
+			}
+		}
+		sort.Slice(zones, func(i, j int) bool {
+			return zones[i].Properties.Name < zones[j].Properties.Name
+		})
+		start, end, info := fakePage(r, len(zones))
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{
+			"zones":      zones[start:end],
+			"resultInfo": info,
+		})
+	case http.MethodPost:
+		var body zoneDTO
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Properties.Name == "" {
+			writeFakeErrors(w, http.StatusBadRequest, 55001, "Invalid zone specification.")
+			return
+		}
+		if _, ok := f.zones[fakeZoneName(body.Properties.Name)]; ok {
+			writeFakeErrors(w, http.StatusBadRequest, 1802, "Zone already exists in the system.")
+			return
+		}
+		if body.Properties.AccountName != "" && body.Properties.AccountName != fakeAccount {
+			writeFakeErrors(w, http.StatusBadRequest, 60004, "Account not found in the system.")
+			return
+		}
+		switch body.Properties.Type {
+		case "PRIMARY":
+			f.createPrimaryZone(w, body)
+		case "SECONDARY":
+			f.createSecondaryZone(w, body)
+		case "ALIAS":
+			f.createAliasZone(w, body)
+		default:
+			writeFakeErrors(w, http.StatusBadRequest, 55001, fmt.Sprintf("Invalid zone type %q.", body.Properties.Type))
+		}
+	default:
+		writeFakeErrors(w, http.StatusMethodNotAllowed, 405, "Method Not Allowed")
+	}
+}
+
+func (f *fakeUltraDNS) serveZone(w http.ResponseWriter, r *http.Request, z *fakeZone) {
+	switch r.Method {
+	case http.MethodGet:
+		writeFakeJSON(w, http.StatusOK, f.zoneDTO(z))
+	case http.MethodPut:
+		f.updateSecondaryZone(w, r, z)
+	case http.MethodDelete:
+		delete(f.zones, z.zone.Properties.Name)
+		for id, p := range f.probes {
+			if p.zone == z.zone.Properties.Name {
+				delete(f.probes, id)
+			}
+		}
+		f.written(w, http.StatusNoContent)
+	default:
+		writeFakeErrors(w, http.StatusMethodNotAllowed, 405, "Method Not Allowed")
+	}
+}
+
+func (f *fakeUltraDNS) createPrimaryZone(w http.ResponseWriter, body zoneDTO) {
+	info := body.PrimaryCreateInfo
+	if info == nil {
+		writeFakeErrors(w, http.StatusBadRequest, 55001, "primaryCreateInfo is required.")
+		return
+	}
+
+	var original *fakeZone
+	switch info.CreateType {
+	case "NEW":
+	case "COPY":
+		var ok bool
+		if original, ok = f.zones[fakeZoneName(info.OriginalZoneName)]; !ok {
+			writeFakeErrors(w, http.StatusNotFound, 1801, "Zone does not exist in the system.")
+			return
+		}
+	case "TRANSFER":
+		if info.NameServer == nil || info.NameServer.IP == "" {
+			writeFakeErrors(w, http.StatusBadRequest, 55001, "nameServer is required for createType TRANSFER.")
+			return
+		}
+	default:
+		writeFakeErrors(w, http.StatusBadRequest, 55001, fmt.Sprintf("Invalid createType %q.", info.CreateType))
+		return
+	}
+
+	z := f.newZone(body)
+	if original != nil {
+		for k, rrset := range original.rrsets {
+			if k.owner == original.zone.Properties.Name && (k.rrtype == "SOA" || k.rrtype == "NS") {
+				continue
+			}
+			owner := strings.TrimSuffix(strings.TrimSuffix(k.owner, original.zone.Properties.Name), ".")
+			z.rrsets[fakeKey(z, k.rrtype, owner)] = rrset
+		}
+	}
+
+	// Transfers always run in the background
+	if info.CreateType == "TRANSFER" {
+		f.startTask(w)
+		return
+	}
+	f.written(w, http.StatusCreated)
+}
+
+func (f *fakeUltraDNS) createSecondaryZone(w http.ResponseWriter, body zoneDTO) {
+	if !validFakeSecondaryInfo(w, body.SecondaryCreateInfo) {
+		return
+	}
+	f.newZone(body)
+
+	// Secondary zones are transferred in the background
+	f.startTask(w)
+}
+
+// createAliasZone creates an alias of an existing primary zone. Deleting
+// the original later leaves the alias behind.
+func (f *fakeUltraDNS) createAliasZone(w http.ResponseWriter, body zoneDTO) {
+	info := body.AliasCreateInfo
+	if info == nil || info.OriginalZoneName == "" {
+		writeFakeErrors(w, http.StatusBadRequest, 55001, "aliasCreateInfo is required.")
+		return
+	}
+	original, ok := f.zones[fakeZoneName(info.OriginalZoneName)]
+	if !ok {
+		writeFakeErrors(w, http.StatusNotFound, 1801, "Zone does not exist in the system.")
+		return
+	}
+	if original.zone.Properties.Type != "PRIMARY" {
+		writeFakeErrors(w, http.StatusBadRequest, 55001, "Only primary zones can be aliased.")
+		return
+	}
+	f.newZone(body)
+	f.written(w, http.StatusCreated)
+}
+
+// updateSecondaryZone replaces the nameservers and notification address of
+// a secondary zone; the other zone types cannot be updated.
+func (f *fakeUltraDNS) updateSecondaryZone(w http.ResponseWriter, r *http.Request, z *fakeZone) {
+	if z.zone.Properties.Type != "SECONDARY" {
+		writeFakeErrors(w, http.StatusBadRequest, 55001, "Only secondary zones can be updated.")
+		return
+	}
+	var body zoneDTO
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeFakeErrors(w, http.StatusBadRequest, 55001, "Invalid zone specification.")
+		return
+	}
+	info := body.SecondaryCreateInfo
+	if !validFakeSecondaryInfo(w, info) {
+		return
+	}
+	z.zone.PrimaryNameServers = &info.PrimaryNameServers
+	z.zone.NotificationEmailAddress = info.NotificationEmailAddress
+	z.zone.TransferStatusDetails = fakeTransferStatus()
+	z.touch()
+	f.written(w, http.StatusOK)
+}
+
+// validFakeSecondaryInfo answers the request with an error unless info
+// names between one and three primary nameservers.
+func validFakeSecondaryInfo(w http.ResponseWriter, info *secondaryZoneCreateInfo) bool {
+	if info == nil {
+		writeFakeErrors(w, http.StatusBadRequest, 55001, "secondaryCreateInfo is required.")
+		return false
+	}
+	ips := info.PrimaryNameServers.NameServerIPList
+	if len(ips) == 0 || len(ips) > 3 {
+		writeFakeErrors(w, http.StatusBadRequest, 55001, "Between one and three primary nameservers are required.")
+		return false
+	}
+	for i := 0; i < len(ips); i++ {
+		if ns, ok := ips[nameServerKey(i)]; !ok || ns.IP == "" {
+			writeFakeErrors(w, http.StatusBadRequest, 55001, fmt.Sprintf("%s requires an ip.", nameServerKey(i)))
+			return false
+		}
+	}
+	return true
+}
+
+func (f *fakeUltraDNS) serveAccounts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeFakeErrors(w, http.StatusMethodNotAllowed, 405, "Method Not Allowed")
+		return
+	}
+	writeFakeJSON(w, http.StatusOK, udnssdk.AccountListDTO{
+		Accounts: []udnssdk.Account{{
+			AccountName:           fakeAccount,
+			AccountHolderUserName: fakeUsername,
+			OwnerUserName:         fakeUsername,
+			NumberOfUsers:         1,
+			NumberOfGroups:        1,
+			AccountType:           "ORGANIZATION",
+		}},
+		Resultinfo: udnssdk.ResultInfo{TotalCount: 1, ReturnedCount: 1},
+	})
+}
+
+// dirGroup returns the account-level group of grouptype "geo" or "ip"
+// stored under name.
+func (f *fakeUltraDNS) dirGroup(grouptype, name string) (map[string]interface{}, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	g, ok := f.dirGroups[fakeDirGroupKey{account: fakeAccount, grouptype: grouptype, name: name}]
+	return g, ok
+}
+
+func (f *fakeUltraDNS) serveDirGroup(w http.ResponseWriter, r *http.Request, k fakeDirGroupKey) {
+	g, exists := f.dirGroups[k]
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			writeFakeErrors(w, http.StatusNotFound, 70002, "Data not found.")
+			return
+		}
+		writeFakeJSON(w, http.StatusOK, g)
+	case http.MethodPost, http.MethodPut:
+		if r.Method == http.MethodPost && exists {
+			writeFakeErrors(w, http.StatusBadRequest, 9001, fmt.Sprintf("Group %s already exists.", k.name))
+			return
+		}
+		if r.Method == http.MethodPut && !exists {
+			writeFakeErrors(w, http.StatusNotFound, 70002, "Data not found.")
+			return
+		}
+		var group map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&group); err != nil {
+			writeFakeErrors(w, http.StatusBadRequest, 55001, fmt.Sprintf("Invalid JSON: %v", err))
+			return
+		}
+		if group["name"] != k.name {
+			writeFakeErrors(w, http.StatusBadRequest, 55001, "name does not match the URI.")
+			return
+		}
+		f.dirGroups[k] = group
+		if r.Method == http.MethodPost {
+			f.written(w, http.StatusCreated)
+		} else {
+			f.written(w, http.StatusOK)
+		}
+	case http.MethodDelete:
+		if !exists {
+			writeFakeErrors(w, http.StatusNotFound, 70002, "Data not found.")
+			return
+		}
+		delete(f.dirGroups, k)
+		f.written(w, http.StatusNoContent)
+	default:
+		writeFakeErrors(w, http.StatusMethodNotAllowed, 405, "Method Not Allowed")
+	}
+}
+
+func (f *fakeUltraDNS) serveWebForwards(w http.ResponseWriter, r *http.Request, z *fakeZone) {
+	switch r.Method {
+	case http.MethodGet:
+		wfs := []webForwardDTO{}
+		for _, wf := range z.webForwards {
+			wfs = append(wfs, wf)
+		}
+		sort.Slice(wfs, func(i, j int) bool { return wfs[i].GUID < wfs[j].GUID })
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{
+			"webForwards": wfs,
+			"resultInfo": udnssdk.ResultInfo{
+				TotalCount:    len(wfs),
+				ReturnedCount: len(wfs),
+			},
+		})
+	case http.MethodPost:
+		var wf webForwardDTO
+		if !decodeFakeWebForward(w, r, &wf) {
+			return
+		}
+		for _, other := range z.webForwards {
+			if strings.EqualFold(other.RequestTo, wf.RequestTo) {
+				writeFakeErrors(w, http.StatusBadRequest, 10001, fmt.Sprintf("Web forward for %s already exists.", wf.RequestTo))
+				return
+			}
+		}
+		wf.GUID = f.newID()
+		if z.webForwards == nil {
+			z.webForwards = map[string]webForwardDTO{}
+		}
+		z.webForwards[wf.GUID] = wf
+		if !f.noLocation {
+			w.Header().Set("Location", fmt.Sprintf("%s%s/%s", f.URL, r.URL.EscapedPath(), wf.GUID))
+		}
+		var created interface{} = wf
+		if f.noCreatedBody {
+			created = map[string]string{"message": "Successful"}
+		}
+		if f.async {
+			f.startTaskWith(w, created)
+			return
+		}
+		writeFakeJSON(w, http.StatusCreated, created)
+	default:
+		writeFakeErrors(w, http.StatusMethodNotAllowed, 405, "Method Not Allowed")
+	}
+}
+
+func (f *fakeUltraDNS) serveWebForward(w http.ResponseWriter, r *http.Request, z *fakeZone, guid string) {
+	wf, exists := z.webForwards[guid]
+	if !exists {
+		writeFakeErrors(w, http.StatusNotFound, 70002, "Data not found.")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeFakeJSON(w, http.StatusOK, wf)
+	case http.MethodPut:
+		if !decodeFakeWebForward(w, r, &wf) {
+			return
+		}
+		wf.GUID = guid
+		z.webForwards[guid] = wf
+		f.written(w, http.StatusOK)
+	case http.MethodDelete:
+		delete(z.webForwards, guid)
+		f.written(w, http.StatusNoContent)
+	default:
+		writeFakeErrors(w, http.StatusMethodNotAllowed, 405, "Method Not Allowed")
+	}
+}
+
+// decodeFakeWebForward reads a web forward from the body of r, answering
+// with an error if it is invalid.
+func decodeFakeWebForward(w http.ResponseWriter, r *http.Request, wf *webForwardDTO) bool {
+	if err := json.NewDecoder(r.Body).Decode(wf); err != nil {
+		writeFakeErrors(w, http.StatusBadRequest, 55001, fmt.Sprintf("Invalid JSON: %v", err))
+		return false
+	}
+	if wf.RequestTo == "" || wf.DefaultRedirectTo == "" {
+		writeFakeErrors(w, http.StatusBadRequest, 55001, "requestTo and defaultRedirectTo are required.")
+		return false
+	}
+	switch wf.DefaultForwardType {
+	case "HTTP_301_REDIRECT", "HTTP_302_REDIRECT", "HTTP_303_REDIRECT", "HTTP_307_REDIRECT", "FRAMED":
+	default:
+		writeFakeErrors(w, http.StatusBadRequest, 55001, fmt.Sprintf("Invalid defaultForwardType %q.", wf.DefaultForwardType))
+		return false
+	}
+	return true
+}
+
+func (f *fakeUltraDNS) serveMailForwards(w http.ResponseWriter, r *http.Request, z *fakeZone) {
+	switch r.Method {
+	case http.MethodGet:
+		mfs := []mailForwardDTO{}
+		for _, mf := range z.mailForwards {
+			mfs = append(mfs, mf)
+		}
+		sort.Slice(mfs, func(i, j int) bool { return mfs[i].GUID < mfs[j].GUID })
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{
+			"mailForwards": mfs,
+			"resultInfo": udnssdk.ResultInfo{
+				TotalCount:    len(mfs),
+				ReturnedCount: len(mfs),
+			},
+		})
+	case http.MethodPost:
+		var mf mailForwardDTO
+		if !decodeFakeMailForward(w, r, &mf) {
+			return
+		}
+		for _, other := range z.mailForwards {
+			if strings.EqualFold(other.ForwardFrom, mf.ForwardFrom) {
+				writeFakeErrors(w, http.StatusBadRequest, 10001, fmt.Sprintf("Mail forward for %s already exists.", mf.ForwardFrom))
+				return
+			}
+		}
+		mf.GUID = f.newID()
+		if z.mailForwards == nil {
+			z.mailForwards = map[string]mailForwardDTO{}
+		}
+		z.mailForwards[mf.GUID] = mf
+		if !f.noLocation {
+			w.Header().Set("Location", fmt.Sprintf("%s%s/%s", f.URL, r.URL.EscapedPath(), mf.GUID))
+		}
+		var created interface{} = mf
+		if f.noCreatedBody {
+			created = map[string]string{"message": "Successful"}
+		}
+		if f.async {
+			f.startTaskWith(w, created)
+			return
+		}
+		writeFakeJSON(w, http.StatusCreated, created)
+	default:
+		writeFakeErrors(w, http.StatusMethodNotAllowed, 405, "Method Not Allowed")
+	}
+}
+
+func (f *fakeUltraDNS) serveMailForward(w http.ResponseWriter, r *http.Request, z *fakeZone, guid string) {
+	mf, exists := z.mailForwards[guid]
+	if !exists {
+		writeFakeErrors(w, http.StatusNotFound, 70002, "Data not found.")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeFakeJSON(w, http.StatusOK, mf)
+	case http.MethodPut:
+		if !decodeFakeMailForward(w, r, &mf) {
+			return
+		}
+		mf.GUID = guid
+		z.mailForwards[guid] = mf
+		f.written(w, http.StatusOK)
+	case http.MethodDelete:
+		delete(z.mailForwards, guid)
+		f.written(w, http.StatusNoContent)
+	default:
+		writeFakeErrors(w, http.StatusMethodNotAllowed, 405, "Method Not Allowed")
+	}
+}
+
+// decodeFakeMailForward reads a mail forward from the body of r, answering
+// with an error if it is invalid.
+func decodeFakeMailForward(w http.ResponseWriter, r *http.Request, mf *mailForwardDTO) bool {
+	if err := json.NewDecoder(r.Body).Decode(mf); err != nil {
+		writeFakeErrors(w, http.StatusBadRequest, 55001, fmt.Sprintf("Invalid JSON: %v", err))
+		return false
+	}
+	if mf.ForwardFrom == "" || !strings.Contains(mf.ForwardTo, "@") {
+		writeFakeErrors(w, http.StatusBadRequest, 55001, "forwardFrom and forwardTo are required.")
+		return false
+	}
+	return true
+}
+
+func (f *fakeUltraDNS) serveDNSSEC(w http.ResponseWriter, r *http.Request, z *fakeZone) {
+	if r.Method == http.MethodGet {
+		if z.dnssec == nil {
+			writeFakeJSON(w, http.StatusOK, zoneDNSSECDTO{Status: "UNSIGNED"})
+			return
+		}
+		writeFakeJSON(w, http.StatusOK, z.dnssec)
+		return
+	}
+
+	if z.zone.Properties.Type != "PRIMARY" {
+		writeFakeErrors(w, http.StatusBadRequest, 8001, "Only primary zones can be signed.")
+		return
+	}
+	switch r.Method {
+	case http.MethodPost, http.MethodPut:
+		if r.Method == http.MethodPost && z.dnssec != nil {
+			writeFakeErrors(w, http.StatusBadRequest, 8002, "Zone is already signed.")
+			return
+		}
+		if r.Method == http.MethodPut && z.dnssec == nil {
+			writeFakeErrors(w, http.StatusBadRequest, 8003, "Zone is not signed.")
+			return
+		}
+		var body zoneDNSSECDTO
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeFakeErrors(w, http.StatusBadRequest, 55001, fmt.Sprintf("Invalid JSON: %v", err))
+			return
+		}
+		if body.NSECType != "NSEC" && body.NSECType != "NSEC3" {
+			writeFakeErrors(w, http.StatusBadRequest, 55001, fmt.Sprintf("Invalid nsecType %q.", body.NSECType))
+			return
+		}
+		if z.dnssec == nil {
+			z.dnssec = &zoneDNSSECDTO{
+				Status: "SIGNED",
+				Keys:   []dnssecKeyDTO{f.newDNSSECKey(z, "KSK"), f.newDNSSECKey(z, "ZSK")},
+			}
+			z.zone.Properties.DNSSECStatus = "SIGNED"
+		}
+		z.dnssec.NSECType = body.NSECType
+		z.touch()
+		f.written(w, http.StatusOK)
+	case http.MethodDelete:
+		if z.dnssec == nil {
+			writeFakeErrors(w, http.StatusBadRequest, 8003, "Zone is not signed.")
+			return
+		}
+		z.dnssec = nil
+		z.zone.Properties.DNSSECStatus = "UNSIGNED"
+		z.touch()
+		f.written(w, http.StatusNoContent)
+	default:
+		writeFakeErrors(w, http.StatusMethodNotAllowed, 405, "Method Not Allowed")
+	}
+}
+
+func (f *fakeUltraDNS) serveDNSSECRollover(w http.ResponseWriter, r *http.Request, z *fakeZone) {
+	if r.Method != http.MethodPost {
+		writeFakeErrors(w, http.StatusMethodNotAllowed, 405, "Method Not Allowed")
+		return
+	}
+	if z.dnssec == nil {
+		writeFakeErrors(w, http.StatusBadRequest, 8003, "Zone is not signed.")
+		return
+	}
+	var body dnssecRolloverDTO
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeFakeErrors(w, http.StatusBadRequest, 55001, fmt.Sprintf("Invalid JSON: %v", err))
+		return
+	}
+	if body.KeyType != "KSK" && body.KeyType != "ZSK" && body.KeyType != "ALL" {
+		writeFakeErrors(w, http.StatusBadRequest, 55001, fmt.Sprintf("Invalid keyType %q.", body.KeyType))
+		return
+	}
+	for i, k := range z.dnssec.Keys {
+		if body.KeyType == "ALL" || body.KeyType == k.Type {
+			z.dnssec.Keys[i] = f.newDNSSECKey(z, k.Type)
+		}
+	}
+	z.touch()
+	f.written(w, http.StatusOK)
+}
+
+// newDNSSECKey returns a new key of keyType, KSK or ZSK, for z. Key
+// signing keys come with their DS record.
+func (f *fakeUltraDNS) newDNSSECKey(z *fakeZone, keyType string) dnssecKeyDTO {
+	f.nextID++
+	flags := 256
+	if keyType == "KSK" {
+		flags = 257
+	}
+	k := dnssecKeyDTO{
+		Type:         keyType,
+		KeyTag:       10000 + f.nextID,
+		Algorithm:    8,
+		DNSKEYRecord: fmt.Sprintf("%s 86400 IN DNSKEY %d 3 8 AwEAAa%04d", z.zone.Properties.Name, flags, f.nextID),
+	}
+	if keyType == "KSK" {
+		k.DSRecords = []string{fmt.Sprintf("%s 86400 IN DS %d 8 2 %064X", z.zone.Properties.Name, k.KeyTag, f.nextID)}
+	}
+	return k
+}
+
+// zoneDTO returns z with the properties the API computes filled in. TSIG
+// secrets are never returned.
+func (f *fakeUltraDNS) zoneDTO(z *fakeZone) zoneDTO {
+	zone := z.zone
+	if zone.PrimaryNameServers != nil {
+		ips := map[string]nameServerDTO{}
+		for k, ns := range zone.PrimaryNameServers.NameServerIPList {
+			ns.TSIGKeyValue = ""
+			ips[k] = ns
+		}
+		zone.PrimaryNameServers = &nameServerList{NameServerIPList: ips}
+	}
+	zone.Properties.ResourceRecordCount = 0
+	for _, rrset := range z.rrsets {
+		zone.Properties.ResourceRecordCount += len(rrset.RData)
+	}
+	return zone
+}
+
+func (f *fakeUltraDNS) serveRRSets(w http.ResponseWriter, r *http.Request, z *fakeZone, rrtype, owner string) {
+	if r.Method != http.MethodGet {
+		writeFakeErrors(w, http.StatusMethodNotAllowed, 405, "Method Not Allowed")
+		return
+	}
+
+	var want fakeRRSetKey
+	if rrtype != "" && strings.ToUpper(rrtype) != "ANY" {
+		want = fakeKey(z, rrtype, "")
+	}
+	if owner != "" {
+		want.owner = fakeKey(z, "", owner).owner
+	}
+
+	keys := []fakeRRSetKey{}
+	for k := range z.rrsets {
+		if (want.rrtype == "" || k.rrtype == want.rrtype) && (want.owner == "" || k.owner == want.owner) {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		writeFakeErrors(w, http.StatusNotFound, 70002, "Data not found.")
+		return
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].owner != keys[j].owner {
+			return keys[i].owner < keys[j].owner
+		}
+		return keys[i].rrtype < keys[j].rrtype
+	})
+
+	rrsets := make([]udnssdk.RRSet, 0, len(keys))
+	for _, k := range keys {
+		rrsets = append(rrsets, fakeRRSetDTO(k, z.rrsets[k]))
+	}
+	start, end, info := fakePage(r, len(rrsets))
+	writeFakeJSON(w, http.StatusOK, udnssdk.RRSetListDTO{
+		ZoneName:   z.zone.Properties.Name,
+		Rrsets:     rrsets[start:end],
+		Resultinfo: info,
+	})
+}
+
+// fakeRRSetDTO returns rrset the way the API lists it, with a qualified
+// owner name, the numeric code after the type and TXT answers quoted.
+func fakeRRSetDTO(k fakeRRSetKey, rrset udnssdk.RRSet) udnssdk.RRSet {
+	if k.rrtype == "TXT" {
+		rdata := make([]string, len(rrset.RData))
+		for i, s := range rrset.RData {
+			rdata[i] = strconv.Quote(s)
+		}
+		rrset.RData = rdata
+	}
+	rrset.OwnerName = k.owner
+	rrset.RRType = k.rrtype
+	if code, ok := rrtypeCodes[k.rrtype]; ok {
+		rrset.RRType = fmt.Sprintf("%s (%d)", k.rrtype, code)
+	}
+	return rrset
+}
+
+func (f *fakeUltraDNS) serveRRSet(w http.ResponseWriter, r *http.Request, z *fakeZone, k fakeRRSetKey) {
+	if r.Method == http.MethodGet {
+		f.serveRRSets(w, r, z, k.rrtype, k.owner)
+		return
+	}
+
+	_, exists := z.rrsets[k]
+	switch r.Method {
+	case http.MethodPost, http.MethodPut:
+		if r.Method == http.MethodPost && exists {
+			writeFakeErrors(w, http.StatusBadRequest, 2111,
+				fmt.Sprintf("Resource Record of type %d with these attributes already exists in the system.", rrtypeCodes[k.rrtype]))
+			return
+		}
+		if r.Method == http.MethodPut && !exists {
+			writeFakeErrors(w, http.StatusNotFound, 70002, "Data not found.")
+			return
+		}
+		var rrset udnssdk.RRSet
+		if err := json.NewDecoder(r.Body).Decode(&rrset); err != nil {
+			writeFakeErrors(w, http.StatusBadRequest, 55001, fmt.Sprintf("Invalid JSON: %v", err))
+			return
+		}
+		if len(rrset.RData) == 0 {
+			writeFakeErrors(w, http.StatusBadRequest, 55001, "rdata is required.")
+			return
+		}
+		if rrset.TTL == 0 {
+			rrset.TTL = 86400
+		}
+		z.rrsets[k] = rrset
+		z.touch()
+		if r.Method == http.MethodPost {
+			f.written(w, http.StatusCreated)
+		} else {
+			f.written(w, http.StatusOK)
+		}
+	case http.MethodDelete:
+		if !exists {
+			writeFakeErrors(w, http.StatusNotFound, 70002, "Data not found.")
+			return
+		}
+		delete(z.rrsets, k)
+		z.touch()
+		for id, p := range f.probes {
+			if p.zone == z.zone.Properties.Name && p.rrset == k {
+				delete(f.probes, id)
+			}
+		}
+		f.written(w, http.StatusNoContent)
+	default:
+		writeFakeErrors(w, http.StatusMethodNotAllowed, 405, "Method Not Allowed")
+	}
+}
+
+func (f *fakeUltraDNS) serveProbes(w http.ResponseWriter, r *http.Request, z *fakeZone, k fakeRRSetKey) {
+	if _, ok := z.rrsets[k]; !ok {
+		writeFakeErrors(w, http.StatusNotFound, 70002, "Data not found.")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		probes := []map[string]interface{}{}
+		for _, p := range f.probes {
+			if p.zone == z.zone.Properties.Name && p.rrset == k {
+				probes = append(probes, p.probe)
+			}
+		}
+		sort.Slice(probes, func(i, j int) bool {
+			return probes[i]["id"].(string) < probes[j]["id"].(string)
+		})
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{
+			"probes": probes,
+			"resultInfo": udnssdk.ResultInfo{
+				TotalCount:    len(probes),
+				ReturnedCount: len(probes),
+			},
+		})
+	case http.MethodPost:
+		var probe map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&probe); err != nil {
+			writeFakeErrors(w, http.StatusBadRequest, 55001, fmt.Sprintf("Invalid JSON: %v", err))
+			return
+		}
+		id := f.newID()
+		probe["id"] = id
+		f.probes[id] = &fakeProbe{zone: z.zone.Properties.Name, rrset: k, probe: probe}
+		if !f.noLocation {
+			w.Header().Set("Location", fmt.Sprintf("%s%s/%s", f.URL, r.URL.EscapedPath(), id))
+		}
+		f.written(w, http.StatusCreated)
+	default:
+		writeFakeErrors(w, http.StatusMethodNotAllowed, 405, "Method Not Allowed")
+	}
+}
+
+func (f *fakeUltraDNS) serveProbe(w http.ResponseWriter, r *http.Request, z *fakeZone, k fakeRRSetKey, id string) {
+	p, ok := f.probes[id]
+	if !ok || p.zone != z.zone.Properties.Name || p.rrset != k {
+		writeFakeErrors(w, http.StatusNotFound, 70002, "Data not found.")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeFakeJSON(w, http.StatusOK, p.probe)
+	case http.MethodPut:
+		var probe map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&probe); err != nil {
+			writeFakeErrors(w, http.StatusBadRequest, 55001, fmt.Sprintf("Invalid JSON: %v", err))
+			return
+		}
+		probe["id"] = id
+		p.probe = probe
+		f.written(w, http.StatusOK)
+	case http.MethodDelete:
+		delete(f.probes, id)
+		f.written(w, http.StatusNoContent)
+	default:
+		writeFakeErrors(w, http.StatusMethodNotAllowed, 405, "Method Not Allowed")
+	}
+}
+
+// written answers a successful write, either at once or, when the fake
+// is async, with a background task that has already completed.
+func (f *fakeUltraDNS) written(w http.ResponseWriter, status int) {
+	if !f.async {
+		if status == http.StatusNoContent {
+			w.WriteHeader(status)
+			return
+		}
+		writeFakeJSON(w, status, map[string]string{"message": "Successful"})
+		return
+	}
+	f.startTask(w)
+}
+
+// startTask answers with a background task that has already completed.
+func (f *fakeUltraDNS) startTask(w http.ResponseWriter) {
+	f.startTaskWith(w, map[string]string{"message": "Pending"})
+}
+
+// startTaskWith is startTask answering with body.
+func (f *fakeUltraDNS) startTaskWith(w http.ResponseWriter, body interface{}) {
+	id := f.newID()
+	f.tasks[id] = udnssdk.Task{
+		TaskID:         id,
+		TaskStatusCode: "COMPLETE",
+		Message:        "Processing complete",
+		ResultURI:      udnssdk.TaskID(id).ResultURI(),
+	}
+	w.Header().Set("X-Task-Id", id)
+	writeFakeJSON(w, http.StatusAccepted, body)
+}
+
+// newID returns an identifier in the style of the API's probe and task
+// IDs.
+func (f *fakeUltraDNS) newID() string {
+	f.nextID++
+	return fmt.Sprintf("%016X", 0x0608485259D5AC4F+f.nextID)
+}
+
+// fakePage applies the offset and limit query parameters of r to a list
+// of n items, returning the bounds of the page to send.
+func fakePage(r *http.Request, n int) (int, int, udnssdk.ResultInfo) {
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 100
+	}
+	if offset < 0 || offset > n {
+		offset = n
+	}
+	end := offset + limit
+	if end > n {
+		end = n
+	}
+	return offset, end, udnssdk.ResultInfo{
+		TotalCount:    n,
+		Offset:        offset,
+		ReturnedCount: end - offset,
+	}
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.Encode(v)
+}
+
+// writeFakeErrors answers with the list of errors the API uses for most
+// failures.
+func writeFakeErrors(w http.ResponseWriter, status, code int, message string) {
+	writeFakeJSON(w, status, []fakeError{{ErrorCode: code, ErrorMessage: message}})
+}
+
+func TestFakeUltraDNSErrors(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+
+	resp, err := http.Get(fake.URL + "/v2/zones/example.com")
+	assert.Nil(t, err, true)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, true)
+
+	config := Config{Username: fakeUsername, Password: "wrong", BaseURL: fake.URL + "/"}
+	client, err := config.Client()
+	assert.Nil(t, err, true)
+	_, err = client.RRSets.Select(udnssdk.RRSetKey{Zone: "example.com", Type: "A", Name: "www"})
+	assert.NotNil(t, err, true)
+	assert.Contains(t, err.Error(), "Invalid username & password combination", true)
+
+	client = fake.client(t)
+	_, err = client.RRSets.Select(udnssdk.RRSetKey{Zone: "example.com", Type: "A", Name: "www"})
+	uderr, ok := err.(*udnssdk.ErrorResponseList)
+	assert.True(t, ok, true)
+	assert.Equal(t, 70002, uderr.Responses[0].ErrorCode, true)
+
+	_, err = client.RRSets.Select(udnssdk.RRSetKey{Zone: "example.org", Type: "A", Name: "www"})
+	uderr, ok = err.(*udnssdk.ErrorResponseList)
+	assert.True(t, ok, true)
+	assert.Equal(t, 1801, uderr.Responses[0].ErrorCode, true)
+
+	rrset := udnssdk.RRSet{OwnerName: "www", RRType: "A", TTL: 300, RData: []string{"10.0.0.1"}}
+	_, err = client.RRSets.Create(udnssdk.RRSetKey{Zone: "example.com", Type: "A", Name: "www"}, rrset)
+	assert.Nil(t, err, true)
+	_, err = client.RRSets.Create(udnssdk.RRSetKey{Zone: "example.com", Type: "A", Name: "www"}, rrset)
+	uderr, ok = err.(*udnssdk.ErrorResponseList)
+	assert.True(t, ok, true)
+	assert.Equal(t, 2111, uderr.Responses[0].ErrorCode, true)
+}
+
+// testResourceLifecycle takes a resource through create, read, update,
+// import and delete against the fake API. After every step the scalar
+// attributes of the configuration in effect are compared with the state.
+// check, when given, makes further assertions on the state after update.
+// Resources that cannot be updated pass a nil update, and check then sees
+// the state after create.
+func testResourceLifecycle(t *testing.T, fake *fakeUltraDNS, res *schema.Resource,
+	create, update map[string]interface{}, check func(*testing.T, *schema.ResourceData)) {
+	ctx := context.Background()
+	client := fake.client(t)
+
+	d := schema.TestResourceDataRaw(t, res.Schema, create)
+	assertNoDiagErrors(t, "create", res.CreateContext(ctx, d, client))
+	id := d.Id()
+	if id == "" {
+		t.Fatal("create: no ID was set")
+	}
+	assertScalarAttributes(t, "create", d, create)
+
+	if update != nil {
+		d = schema.TestResourceDataRaw(t, res.Schema, update)
+		d.SetId(id)
+		assertNoDiagErrors(t, "update", res.UpdateContext(ctx, d, client))
+		assertScalarAttributes(t, "update", d, update)
+	} else {
+		update = create
+	}
+	if check != nil {
+		check(t, d)
+	}
+
+	d = res.TestResourceData()
+	d.SetId(id)
+	imported, err := res.Importer.StateContext(ctx, d, client)
+	if err != nil || len(imported) != 1 {
+		t.Fatalf("import %s: %v", id, err)
+	}
+	d = imported[0]
+	assertNoDiagErrors(t, "read after import", res.ReadContext(ctx, d, client))
+	if d.Id() != id {
+		t.Fatalf("read after import: ID is %q, want %q", d.Id(), id)
+	}
+	assertScalarAttributes(t, "read after import", d, update)
+
+	assertNoDiagErrors(t, "delete", res.DeleteContext(ctx, d, client))
+
+	d = schema.TestResourceDataRaw(t, res.Schema, update)
+	d.SetId(id)
+	assertNoDiagErrors(t, "read after delete", res.ReadContext(ctx, d, client))
+	if d.Id() != "" {
+		t.Fatalf("read after delete: ID is %q, want it removed from state", d.Id())
+	}
+}
+
+func assertNoDiagErrors(t *testing.T, step string, diags diag.Diagnostics) {
+	t.Helper()
+	if diags.HasError() {
+		t.Fatalf("%s: %+v", step, diags)
+	}
+}
+
+// assertScalarAttributes compares the strings, numbers and bools of a raw
+// configuration with the state in d.
+func assertScalarAttributes(t *testing.T, step string, d *schema.ResourceData, raw map[string]interface{}) {
+	t.Helper()
+	for k, v := range raw {
+		switch v.(type) {
+		case string, int, bool:
+			if got := fmt.Sprint(d.Get(k)); got != fmt.Sprint(v) {
+				t.Errorf("%s: %s is %q, want %q", step, k, got, fmt.Sprint(v))
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package ultradns
+
+import (
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+)
+
+// loggingTransport writes every request to and response from the UltraDNS
+// API to the Terraform log at TRACE level. Credentials and tokens are
+// redacted before anything is written. Where the log goes is decided by
+// TF_LOG and TF_LOG_PATH; nothing is dumped below TRACE.
+type loggingTransport struct {
+	base http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if logging.LogLevel() != "TRACE" {
+		return t.base.RoundTrip(req)
+	}
+
+	reqData, err := httputil.DumpRequestOut(req, true)
+	if err != nil {
+		log.Printf("[ERROR] UltraDNS API request could not be logged: %s", err)
+	} else {
+		log.Printf("[TRACE] UltraDNS API Request:\n%s", redact(reqData))
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		log.Printf("[TRACE] UltraDNS API Request failed: %s", err)
+		return resp, err
+	}
+
+	respData, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		log.Printf("[ERROR] UltraDNS API response could not be logged: %s", err)
+	} else {
+		log.Printf("[TRACE] UltraDNS API Response:\n%s", redact(respData))
+	}
+	return resp, nil
+}
+
+var (
+	// sensitiveHeaders matches header lines that carry credentials
+	sensitiveHeaders = regexp.MustCompile(`(?im)^((?:Proxy-)?Authorization|(?:Set-)?Cookie):[^\r\n]*`)
+	// sensitiveFormFields matches credentials sent form-encoded to the token endpoint
+	sensitiveFormFields = regexp.MustCompile(`\b(password|access_token|refresh_token|client_secret)=[^&\s]*`)
+	// sensitiveJSONFields matches credentials and secrets in JSON bodies
+	sensitiveJSONFields = regexp.MustCompile(`"(password|accessToken|access_token|refreshToken|refresh_token|tsigKeyValue|secret)"(\s*:\s*)"(?:[^"\\]|\\.)*"`)
+)
+
+// redact blanks out credentials and tokens in a dumped request or response.
+func redact(dump []byte) string {
+	s := sensitiveHeaders.ReplaceAll(dump, []byte("$1: REDACTED"))
+	s = sensitiveFormFields.ReplaceAll(s, []byte("$1=REDACTED"))
+	s = sensitiveJSONFields.ReplaceAll(s, []byte(`"$1"$2"REDACTED"`))
+	return string(s)
+}
@@ -0,0 +1,63 @@
+package ultradns
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	dump := "POST /v2/authorization/token HTTP/1.1\r\n" +
+		"Authorization: Bearer secret-access\r\n" +
+		"Content-Type: application/x-www-form-urlencoded\r\n\r\n" +
+		"grant_type=password&username=jdoe&password=hunter2\n" +
+		`{"accessToken": "abc", "refresh_token":"def", "tsigKeyValue":"c2VjcmV0", "name":"www"}`
+
+	out := redact([]byte(dump))
+	for _, secret := range []string{"secret-access", "hunter2", `"abc"`, `"def"`, "c2VjcmV0"} {
+		assert.NotContains(t, out, secret, true)
+	}
+	assert.Contains(t, out, "Authorization: REDACTED\r\n", true)
+	assert.Contains(t, out, "username=jdoe&password=REDACTED", true)
+	assert.Contains(t, out, `"accessToken": "REDACTED"`, true)
+	assert.Contains(t, out, `"name":"www"`, true)
+}
+
+func TestLoggingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"accessToken":"issued"}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	client := &http.Client{Transport: &loggingTransport{base: http.DefaultTransport}}
+	get := func() {
+		req, _ := http.NewRequest("GET", server.URL+"/zones", nil)
+		req.Header.Set("Authorization", "Bearer sent")
+		resp, err := client.Do(req)
+		assert.Nil(t, err, true)
+		resp.Body.Close()
+	}
+
+	os.Setenv("TF_LOG", "DEBUG")
+	defer os.Unsetenv("TF_LOG")
+	get()
+	assert.Equal(t, "", buf.String(), true)
+
+	os.Setenv("TF_LOG", "TRACE")
+	get()
+	out := buf.String()
+	assert.True(t, strings.Contains(out, "GET /zones HTTP/1.1"), true)
+	assert.True(t, strings.Contains(out, `"accessToken":"REDACTED"`), true)
+	assert.False(t, strings.Contains(out, "sent"), true)
+	assert.False(t, strings.Contains(out, "issued"), true)
+}
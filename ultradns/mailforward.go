@@ -0,0 +1,100 @@
+package ultradns
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/ultradns/ultradns-sdk-go"
+)
+
+// udnssdk has no mail forward support, so the mailforwards endpoints are
+// called through the client's Do with the DTO below.
+
+// mailForwardDTO is a mail forward as the mailforwards endpoints send and
+// receive it. Mail to ForwardFrom@zone is forwarded to ForwardTo. The GUID
+// is assigned by UltraDNS.
+type mailForwardDTO struct {
+	GUID        string `json:"guid,omitempty"`
+	ForwardFrom string `json:"forwardFrom"`
+	ForwardTo   string `json:"forwardTo"`
+}
+
+// mailForwardsURI returns the URI of the mail forwards of zone.
+func mailForwardsURI(zone string) string {
+	return fmt.Sprintf("%s/mailforwards", zoneURI(zone))
+}
+
+// mailForwardURI returns the URI of the mail forward with guid in zone.
+func mailForwardURI(zone, guid string) string {
+	return fmt.Sprintf("%s/%s", mailForwardsURI(zone), guid)
+}
+
+// getMailForward returns the mail forward with guid in zone.
+func (c *Client) getMailForward(zone, guid string) (mailForwardDTO, error) {
+	var mf mailForwardDTO
+	_, err := c.Do("GET", mailForwardURI(zone, guid), nil, &mf)
+	return mf, err
+}
+
+// createMailForward creates mf in zone and returns its GUID, taken from
+// the response or, failing that, from its Location. The GUID is empty if
+// neither names it; findMailForward looks the forward up once the create
+// has finished.
+func (c *Client) createMailForward(zone string, mf mailForwardDTO) (string, *http.Response, error) {
+	var created mailForwardDTO
+	resp, err := c.Do("POST", mailForwardsURI(zone), mf, &created)
+	if err != nil {
+		return "", resp, err
+	}
+	guid := created.GUID
+	if guid == "" && resp != nil {
+		if loc := resp.Header.Get("Location"); loc != "" {
+			guid = path.Base(loc)
+		}
+	}
+	return guid, resp, nil
+}
+
+// findMailForward returns the GUID of the mail forward for forwardFrom in
+// zone.
+func (c *Client) findMailForward(zone, forwardFrom string) (string, error) {
+	var list struct {
+		MailForwards []mailForwardDTO `json:"mailForwards"`
+	}
+	if _, err := c.Do("GET", mailForwardsURI(zone), nil, &list); err != nil {
+		return "", err
+	}
+	for _, mf := range list.MailForwards {
+		if strings.EqualFold(mf.ForwardFrom, forwardFrom) && mf.GUID != "" {
+			return mf.GUID, nil
+		}
+	}
+	return "", fmt.Errorf("mail forward for %s not found in zone %s", forwardFrom, zone)
+}
+
+// updateMailForward replaces the mail forward with guid in zone by mf.
+func (c *Client) updateMailForward(zone, guid string, mf mailForwardDTO) (*http.Response, error) {
+	var ignored interface{}
+	return c.Do("PUT", mailForwardURI(zone, guid), mf, &ignored)
+}
+
+// deleteMailForward deletes the mail forward with guid in zone.
+func (c *Client) deleteMailForward(zone, guid string) (*http.Response, error) {
+	return c.Do("DELETE", mailForwardURI(zone, guid), nil, nil)
+}
+
+// zoneHasMX reports whether zone has MX records at its apex, which take
+// precedence over mail forwarding.
+func (c *Client) zoneHasMX(zone string) (bool, error) {
+	apex := strings.TrimSuffix(zone, ".") + "."
+	rrsets, err := c.RRSets.Select(udnssdk.RRSetKey{Zone: zone, Type: "MX", Name: apex})
+	if err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return len(rrsets) > 0, nil
+}
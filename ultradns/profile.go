@@ -0,0 +1,101 @@
+package ultradns
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ultradns/ultradns-sdk-go"
+)
+
+// udnssdk only knows the dirpool, rdpool, sbpool and tcpool profiles. The
+// profiles of the other pool types are defined here and converted to and
+// from a RawProfile through JSON.
+
+const (
+	// slbPoolSchema is the schema URI for a Simple Load Balancing pool
+	// profile
+	slbPoolSchema udnssdk.ProfileSchema = "http://schemas.ultradns.com/SLBPool.jsonschema"
+	// sfPoolSchema is the schema URI for a Simple Failover pool profile
+	sfPoolSchema udnssdk.ProfileSchema = "http://schemas.ultradns.com/SFPool.jsonschema"
+)
+
+// slbPoolProfile wraps a Profile for a Simple Load Balancing pool
+type slbPoolProfile struct {
+	Context                  udnssdk.ProfileSchema `json:"@context"`
+	Description              string                `json:"description"`
+	RegionFailureSensitivity string                `json:"regionFailureSensitivity"`
+	ServingPreference        string                `json:"servingPreference"`
+	ResponseMethod           string                `json:"responseMethod"`
+	Monitor                  poolMonitor           `json:"monitor"`
+	AllFailRecord            slbAllFailRecord      `json:"allFailRecord"`
+	RDataInfo                []slbRDataInfo        `json:"rdataInfo"`
+	Status                   string                `json:"status,omitempty"`
+}
+
+// poolMonitor is the HTTP check a Simple Load Balancing or Simple Failover
+// pool runs against its records
+type poolMonitor struct {
+	Method          string `json:"method"`
+	URL             string `json:"url"`
+	TransmittedData string `json:"transmittedData,omitempty"`
+	SearchString    string `json:"searchString,omitempty"`
+}
+
+// slbAllFailRecord is served when all records of a Simple Load Balancing
+// pool fail
+type slbAllFailRecord struct {
+	RData       string `json:"rdata"`
+	Description string `json:"description,omitempty"`
+	Serving     bool   `json:"serving,omitempty"`
+}
+
+// slbRDataInfo wraps the rdataInfo object of a slbPoolProfile
+type slbRDataInfo struct {
+	Description      string `json:"description,omitempty"`
+	ProbingEnabled   bool   `json:"probingEnabled"`
+	ForcedState      string `json:"forcedState"`
+	AvailableToServe bool   `json:"availableToServe,omitempty"`
+}
+
+// sfPoolProfile wraps a Profile for a Simple Failover pool
+type sfPoolProfile struct {
+	Context                  udnssdk.ProfileSchema `json:"@context"`
+	Description              string                `json:"description"`
+	LiveRecordState          string                `json:"liveRecordState"`
+	LiveRecordDescription    string                `json:"liveRecordDescription,omitempty"`
+	RegionFailureSensitivity string                `json:"regionFailureSensitivity"`
+	Monitor                  poolMonitor           `json:"monitor"`
+	BackupRecord             sfBackupRecord        `json:"backupRecord"`
+	Status                   string                `json:"status,omitempty"`
+}
+
+// sfBackupRecord is served when the live record of a Simple Failover pool
+// fails
+type sfBackupRecord struct {
+	RData       string `json:"rdata"`
+	Description string `json:"description,omitempty"`
+}
+
+// rawProfile converts a pool profile to a RawProfile
+func rawProfile(p interface{}) (udnssdk.RawProfile, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	var rp udnssdk.RawProfile
+	err = json.Unmarshal(data, &rp)
+	return rp, err
+}
+
+// decodeRawProfile extracts the profile of schema from rp into p, which
+// must be a pointer to a profile struct
+func decodeRawProfile(rp udnssdk.RawProfile, schema udnssdk.ProfileSchema, p interface{}) error {
+	if c := fmt.Sprint(rp["@context"]); c != string(schema) {
+		return fmt.Errorf("incorrect JSON-LD @context %s, want %s", c, schema)
+	}
+	data, err := json.Marshal(rp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, p)
+}
@@ -1,54 +1,219 @@
 package ultradns
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-// Provider returns a terraform.ResourceProvider.
-func Provider() terraform.ResourceProvider {
+// Provider returns a *schema.Provider.
+func Provider() *schema.Provider {
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"username": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_USERNAME", nil),
 				Description: "UltraDNS Username.",
 			},
 
 			"password": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_PASSWORD", nil),
 				Description: "UltraDNS User Password",
 			},
+			"access_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_ACCESS_TOKEN", nil),
+				Description: "UltraDNS OAuth access token, used instead of username and password.",
+			},
+			"refresh_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_REFRESH_TOKEN", nil),
+				Description: "UltraDNS OAuth refresh token, used to renew an expired access token.",
+			},
 			"baseurl": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_BASEURL", nil),
 				Description: "UltraDNS Base URL",
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of times a request failing with a transient error is retried.",
+			},
+			"retry_min_wait": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Seconds to wait before the first retry.",
+			},
+			"retry_max_wait": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Upper bound in seconds for the wait between retries.",
+			},
+			"ca_bundle_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_CA_BUNDLE_FILE", nil),
+				Description: "PEM file with additional certificate authorities to trust.",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip verification of the server certificate.",
+			},
+			"client_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM file with a client certificate presented to the server.",
+			},
+			"client_key_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM file with the private key of client_cert_file.",
+			},
+			"http_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_HTTP_PROXY", nil),
+				Description: "URL of the proxy used to reach the API.",
+			},
+			"request_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Seconds after which an API call, including its retries, is abandoned.",
+			},
+			"default_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_DEFAULT_ZONE", nil),
+				Description: "Zone used by resources that do not set one.",
+			},
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "TTL used by resources that do not set one.",
+			},
+			"default_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Description:  "Description used by pools that do not set one.",
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_ACCOUNT_NAME", nil),
+				Description: "Account that account-level objects belong to.",
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Upper bound for API requests sent per second, retries included.",
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Upper bound for API requests in flight at the same time.",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"ultradns_dirpool":    resourceUltradnsDirpool(),
-			"ultradns_probe_http": resourceUltradnsProbeHTTP(),
-			"ultradns_probe_ping": resourceUltradnsProbePing(),
-			"ultradns_record":     resourceUltradnsRecord(),
-			"ultradns_tcpool":     resourceUltradnsTcpool(),
-			"ultradns_rdpool":     resourceUltradnsRdpool(),
+			"ultradns_alias_zone":        resourceUltradnsAliasZone(),
+			"ultradns_dirpool":           resourceUltradnsDirpool(),
+			"ultradns_dirpool_geo_group": resourceUltradnsDirpoolGeoGroup(),
+			"ultradns_dirpool_ip_group":  resourceUltradnsDirpoolIPGroup(),
+			"ultradns_mail_forward":      resourceUltradnsMailForward(),
+			"ultradns_probe_dns":         resourceUltradnsProbeDNS(),
+			"ultradns_probe_ftp":         resourceUltradnsProbeFTP(),
+			"ultradns_probe_http":        resourceUltradnsProbeHTTP(),
+			"ultradns_probe_ping":        resourceUltradnsProbePing(),
+			"ultradns_probe_smtp":        resourceUltradnsProbeSMTP(),
+			"ultradns_probe_smtp_send":   resourceUltradnsProbeSMTPSend(),
+			"ultradns_probe_tcp":         resourceUltradnsProbeTCP(),
+			"ultradns_record":            resourceUltradnsRecord(),
+			"ultradns_tcpool":            resourceUltradnsTcpool(),
+			"ultradns_rdpool":            resourceUltradnsRdpool(),
+			"ultradns_sbpool":            resourceUltradnsSbpool(),
+			"ultradns_secondary_zone":    resourceUltradnsSecondaryZone(),
+			"ultradns_sfpool":            resourceUltradnsSfpool(),
+			"ultradns_slbpool":           resourceUltradnsSlbpool(),
+			"ultradns_web_forward":       resourceUltradnsWebForward(),
+			"ultradns_zone":              resourceUltradnsZone(),
+			"ultradns_zone_dnssec":       resourceUltradnsZoneDNSSEC(),
 		},
 
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
 	}
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	config := Config{
-		Username: d.Get("username").(string),
-		Password: d.Get("password").(string),
-		BaseURL:  d.Get("baseurl").(string),
+		Username:     d.Get("username").(string),
+		Password:     d.Get("password").(string),
+		AccessToken:  d.Get("access_token").(string),
+		RefreshToken: d.Get("refresh_token").(string),
+		BaseURL:      d.Get("baseurl").(string),
+		MaxRetries:   d.Get("max_retries").(int),
+		RetryMinWait: time.Duration(d.Get("retry_min_wait").(int)) * time.Second,
+		RetryMaxWait: time.Duration(d.Get("retry_max_wait").(int)) * time.Second,
+
+		CABundleFile:       d.Get("ca_bundle_file").(string),
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+		ClientCertFile:     d.Get("client_cert_file").(string),
+		ClientKeyFile:      d.Get("client_key_file").(string),
+		HTTPProxy:          d.Get("http_proxy").(string),
+		RequestTimeout:     time.Duration(d.Get("request_timeout").(int)) * time.Second,
+
+		DefaultZone:        d.Get("default_zone").(string),
+		DefaultTTL:         d.Get("default_ttl").(int),
+		DefaultDescription: d.Get("default_description").(string),
+
+		AccountName: d.Get("account_name").(string),
+
+		RequestsPerSecond:     d.Get("requests_per_second").(float64),
+		MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
 	}
 
-	return config.Client()
+	if config.AccessToken == "" && config.RefreshToken == "" &&
+		(config.Username == "" || config.Password == "") {
+		return nil, diag.Errorf("either username and password, or access_token/refresh_token must be configured")
+	}
+
+	var diags diag.Diagnostics
+	if config.InsecureSkipVerify {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Server certificate is not verified",
+			Detail:        "insecure_skip_verify is set, so the identity of the UltraDNS API is not checked. Use ca_bundle_file to trust a private certificate authority instead.",
+			AttributePath: cty.GetAttrPath("insecure_skip_verify"),
+		})
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	return client, diags
 }
@@ -1,16 +1,18 @@
 package ultradns
 
 import (
+	"context"
 	"os"
 	"testing"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 )
 
-var testAccProviders map[string]terraform.ResourceProvider
+var testAccProviders map[string]*schema.Provider
 var testAccProvider *schema.Provider
 
 func setSchemaProvider() *schema.Resource {
@@ -28,31 +30,135 @@ func setSchemaProvider() *schema.Resource {
 				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_PASSWORD", nil),
 				Description: "UltraDNS User Password",
 			},
+			"access_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_ACCESS_TOKEN", nil),
+				Description: "UltraDNS OAuth access token, used instead of username and password.",
+			},
+			"refresh_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_REFRESH_TOKEN", nil),
+				Description: "UltraDNS OAuth refresh token, used to renew an expired access token.",
+			},
 			"baseurl": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_BASEURL", nil),
 				Description: "UltraDNS Base URL",
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of times a request failing with a transient error is retried.",
+			},
+			"retry_min_wait": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Seconds to wait before the first retry.",
+			},
+			"retry_max_wait": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Upper bound in seconds for the wait between retries.",
+			},
+			"ca_bundle_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_CA_BUNDLE_FILE", nil),
+				Description: "PEM file with additional certificate authorities to trust.",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip verification of the server certificate.",
+			},
+			"client_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM file with a client certificate presented to the server.",
+			},
+			"client_key_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM file with the private key of client_cert_file.",
+			},
+			"http_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_HTTP_PROXY", nil),
+				Description: "URL of the proxy used to reach the API.",
+			},
+			"request_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Seconds after which an API call, including its retries, is abandoned.",
+			},
+			"default_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_DEFAULT_ZONE", nil),
+				Description: "Zone used by resources that do not set one.",
+			},
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "TTL used by resources that do not set one.",
+			},
+			"default_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Description:  "Description used by pools that do not set one.",
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ULTRADNS_ACCOUNT_NAME", nil),
+				Description: "Account that account-level objects belong to.",
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Upper bound for API requests sent per second, retries included.",
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Upper bound for API requests in flight at the same time.",
+			},
 		},
 	}
 }
 
 func init() {
-	testAccProvider = Provider().(*schema.Provider)
-	testAccProviders = map[string]terraform.ResourceProvider{
+	testAccProvider = Provider()
+	testAccProviders = map[string]*schema.Provider{
 		"ultradns": testAccProvider,
 	}
 }
 
 func TestProvider(t *testing.T) {
-	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
+	if err := Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 }
 
 func TestProvider_impl(t *testing.T) {
-	var _ terraform.ResourceProvider = Provider()
+	var _ *schema.Provider = Provider()
 }
 
 func TestProviderConfigure(t *testing.T) {
@@ -63,21 +169,56 @@ func TestProviderConfigure(t *testing.T) {
 	resourceData.Set("username", "abcd")
 	resourceData.Set("password", "abcd")
 	resourceData.Set("baseurl", "abcd")
+	resourceData.Set("max_retries", 3)
+	resourceData.Set("retry_min_wait", 1)
+	resourceData.Set("retry_max_wait", 30)
 
 	config := Config{
-		Username: "abcd",
-		Password: "abcd",
-		BaseURL:  "abcd",
+		Username:     "abcd",
+		Password:     "abcd",
+		BaseURL:      "abcd",
+		MaxRetries:   3,
+		RetryMinWait: time.Second,
+		RetryMaxWait: 30 * time.Second,
 	}
 
 	expected, _ := config.Client()
 
-	actualData, _ := providerConfigure(resourceData)
+	actualData, _ := providerConfigure(context.Background(), resourceData)
 	log.Infof("%#v", actualData)
 	assert.Equal(t, expected, actualData, true)
 
 }
 
+func TestProviderConfigureMissingCredentials(t *testing.T) {
+	resourceRecordObj := setSchemaProvider()
+	resourceData := resourceRecordObj.TestResourceData()
+
+	resourceData.Set("username", "abcd")
+	resourceData.Set("baseurl", "abcd")
+
+	_, err := providerConfigure(context.Background(), resourceData)
+	assert.NotNil(t, err, true)
+}
+
+func TestProviderConfigureTokens(t *testing.T) {
+	resourceRecordObj := setSchemaProvider()
+	resourceData := resourceRecordObj.TestResourceData()
+
+	resourceData.Set("access_token", "access")
+	resourceData.Set("refresh_token", "refresh")
+	resourceData.Set("baseurl", "abcd")
+
+	actualData, err := providerConfigure(context.Background(), resourceData)
+	assert.Nil(t, err, true)
+
+	client := actualData.(*Client)
+	transport, ok := client.HTTPClient.Transport.(*tokenTransport)
+	assert.True(t, ok, true)
+	assert.Equal(t, "access", transport.source.token.AccessToken, true)
+	assert.Equal(t, "refresh", transport.source.token.RefreshToken, true)
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("ULTRADNS_USERNAME"); v == "" {
 		t.Fatal("ULTRADNS_USERNAME must be set for acceptance tests")
@@ -0,0 +1,84 @@
+package ultradns
+
+import (
+	"io"
+	"net/http"
+	"sync"
+
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
+)
+
+// rateLimitTransport keeps the provider within the API's rate limits. Every
+// attempt, retries included, waits for a token from limiter and for a free
+// slot in inFlight. Both are shared by all resources of a provider, as they
+// all use the same client. A nil limiter or inFlight means no limit.
+type rateLimitTransport struct {
+	base     http.RoundTripper
+	limiter  *rate.Limiter
+	inFlight *semaphore.Weighted
+}
+
+func newRateLimitTransport(base http.RoundTripper, requestsPerSecond float64, maxConcurrent int) http.RoundTripper {
+	if requestsPerSecond <= 0 && maxConcurrent <= 0 {
+		return base
+	}
+
+	t := &rateLimitTransport{base: base}
+	if requestsPerSecond > 0 {
+		burst := int(requestsPerSecond)
+		if burst < 1 {
+			burst = 1
+		}
+		t.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+	if maxConcurrent > 0 {
+		t.inFlight = semaphore.NewWeighted(int64(maxConcurrent))
+	}
+	return t
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if t.inFlight != nil {
+		if err := t.inFlight.Acquire(ctx, 1); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := t.roundTrip(req)
+	if t.inFlight == nil {
+		return resp, err
+	}
+	if err != nil {
+		t.inFlight.Release(1)
+		return nil, err
+	}
+	// The slot stays taken until the body has been streamed
+	resp.Body = &releasingBody{
+		ReadCloser: resp.Body,
+		release:    func() { t.inFlight.Release(1) },
+	}
+	return resp, nil
+}
+
+func (t *rateLimitTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	if t.limiter != nil {
+		if err := t.limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	return t.base.RoundTrip(req)
+}
+
+// releasingBody calls release once, when the body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,100 @@
+package ultradns
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitTransportUnlimited(t *testing.T) {
+	assert.Equal(t, http.DefaultTransport, newRateLimitTransport(http.DefaultTransport, 0, 0), true)
+}
+
+func TestRateLimitTransportConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, peak := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, 0, 2)}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			assert.Nil(t, err, true)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+	assert.True(t, peak <= 2, true)
+}
+
+func TestRateLimitTransportConcurrencyWhileStreaming(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, peak := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		mu.Unlock()
+		// the headers go out at once, the body takes a while
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte("{}"))
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, 0, 2)}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			assert.Nil(t, err, true)
+			ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+	assert.True(t, peak <= 2, true)
+}
+
+func TestRateLimitTransportRate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	// A burst of 50 passes at once, the remaining 10 take 200ms
+	client := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, 50, 0)}
+	start := time.Now()
+	for i := 0; i < 60; i++ {
+		resp, err := client.Get(server.URL)
+		assert.Nil(t, err, true)
+		resp.Body.Close()
+	}
+	assert.True(t, time.Since(start) >= 150*time.Millisecond, true)
+}
@@ -0,0 +1,196 @@
+package ultradns
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceUltradnsAliasZone() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUltradnsAliasZoneCreate,
+		ReadContext:   resourceUltradnsAliasZoneRead,
+		DeleteContext: resourceUltradnsAliasZoneDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUltradnsAliasZoneImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"original_zone_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressZoneNameCase,
+			},
+			// Optional
+			"account_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressAccountNameCase,
+			},
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// suppressZoneNameCase hides differences between spellings of the same
+// zone name, which UltraDNS treats case-insensitively and with or without
+// the trailing dot.
+func suppressZoneNameCase(k, old, new string, d *schema.ResourceData) bool {
+	return old != "" && new != "" && sameZoneName(old, new)
+}
+
+// sameZoneName reports whether a and b name the same zone.
+func sameZoneName(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
+
+// CRUD Operations
+
+func resourceUltradnsAliasZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	account, err := client.account(d.Get("account_name").(string))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	z := makeAliasZoneDTO(d, account)
+	log.Printf("[INFO] ultradns_alias_zone create: %s -> %s", z.Properties.Name, z.AliasCreateInfo.OriginalZoneName)
+	resp, err := client.createZone(z)
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	d.SetId(z.Properties.Name)
+	log.Printf("[INFO] ultradns_alias_zone.id: %v", d.Id())
+
+	return resourceUltradnsAliasZoneRead(ctx, d, meta)
+}
+
+func resourceUltradnsAliasZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	z, err := client.getZone(d.Id())
+	if err != nil {
+		if isZoneNotFound(err) {
+			log.Printf("[WARN] ultradns_alias_zone %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("not found: %v", err)
+	}
+	if z.Properties.Type != "ALIAS" {
+		return diag.Errorf("zone %s is a %s zone, not an alias zone", d.Id(), z.Properties.Type)
+	}
+
+	populateResourceDataFromAliasZone(z, d)
+
+	// Without the name of the original there is nothing to check
+	if z.OriginalZoneName == "" {
+		return nil
+	}
+
+	// An alias whose original zone was deleted serves nothing. Forgetting
+	// the original makes the next plan replace the alias, which fails
+	// with a clear error until the original zone exists again.
+	_, err = client.getZone(z.OriginalZoneName)
+	if err != nil {
+		if !isZoneNotFound(err) {
+			return diag.Errorf("original zone not found: %v", err)
+		}
+		log.Printf("[WARN] ultradns_alias_zone %s: original zone %s not found", d.Id(), z.OriginalZoneName)
+		d.Set("original_zone_name", "")
+		return diag.Diagnostics{{
+			Severity:      diag.Warning,
+			Summary:       "Original zone deleted",
+			Detail:        "The original zone " + z.OriginalZoneName + " of alias zone " + d.Id() + " no longer exists; the alias zone will be replaced.",
+			AttributePath: cty.GetAttrPath("original_zone_name"),
+		}}
+	}
+	return nil
+}
+
+func resourceUltradnsAliasZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	log.Printf("[INFO] ultradns_alias_zone delete: %s", d.Id())
+	resp, err := client.deleteZone(d.Id())
+	if err != nil {
+		if isZoneNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("delete failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("delete failed: %v", err)
+	}
+
+	return nil
+}
+
+// State function to take the alias zone name from the ID
+func resourceUltradnsAliasZoneImport(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return setZoneResourceAndParseId(d)
+}
+
+// Resource Helpers
+
+// makeAliasZoneDTO converts ResourceData into the request creating an alias
+// zone in account.
+func makeAliasZoneDTO(d *schema.ResourceData, account string) zoneDTO {
+	return zoneDTO{
+		Properties: zoneProperties{
+			Name:        d.Get("name").(string),
+			AccountName: account,
+			Type:        "ALIAS",
+		},
+		AliasCreateInfo: &aliasZoneCreateInfo{
+			OriginalZoneName: d.Get("original_zone_name").(string),
+		},
+	}
+}
+
+// populateResourceDataFromAliasZone takes an alias zone and populates the
+// ResourceData
+func populateResourceDataFromAliasZone(z zoneDTO, d *schema.ResourceData) {
+	// The API answers with canonical names; keep the configured spelling
+	if d.Get("name").(string) == "" {
+		d.Set("name", z.Properties.Name)
+	}
+	// An answer without the original keeps the one in state
+	if z.OriginalZoneName != "" && !sameZoneName(d.Get("original_zone_name").(string), z.OriginalZoneName) {
+		d.Set("original_zone_name", z.OriginalZoneName)
+	}
+	d.Set("account_name", z.Properties.AccountName)
+	d.Set("status", z.Properties.Status)
+}
@@ -0,0 +1,91 @@
+package ultradns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceUltradnsAliasZoneLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "original.com")
+	testResourceLifecycle(t, fake, resourceUltradnsAliasZone(),
+		map[string]interface{}{
+			"name":               "alias.com.",
+			"original_zone_name": "original.com.",
+		},
+		nil,
+		func(t *testing.T, d *schema.ResourceData) {
+			assert.Equal(t, fakeAccount, d.Get("account_name"), true)
+			assert.Equal(t, "ACTIVE", d.Get("status"), true)
+		})
+}
+
+func TestResourceUltradnsAliasZoneOriginalMissing(t *testing.T) {
+	fake := newFakeUltraDNS(t)
+
+	d := schema.TestResourceDataRaw(t, resourceUltradnsAliasZone().Schema, map[string]interface{}{
+		"name":               "alias.com.",
+		"original_zone_name": "missing.com.",
+	})
+	diags := resourceUltradnsAliasZoneCreate(context.Background(), d, fake.client(t))
+	assert.True(t, diags.HasError(), true)
+	assert.Contains(t, diags[0].Summary, "Zone does not exist", true)
+}
+
+func TestResourceUltradnsAliasZoneOriginalDeleted(t *testing.T) {
+	fake := newFakeUltraDNS(t, "original.com")
+	client := fake.client(t)
+
+	d := schema.TestResourceDataRaw(t, resourceUltradnsAliasZone().Schema, map[string]interface{}{
+		"name":               "alias.com.",
+		"original_zone_name": "Original.com",
+	})
+	assertNoDiagErrors(t, "create", resourceUltradnsAliasZoneCreate(context.Background(), d, client))
+	assert.Equal(t, "Original.com", d.Get("original_zone_name"), true)
+
+	delete(fake.zones, "original.com.")
+	diags := resourceUltradnsAliasZoneRead(context.Background(), d, client)
+	assertNoDiagErrors(t, "read", diags)
+	assert.Equal(t, 1, len(diags), true)
+	assert.Equal(t, diag.Warning, diags[0].Severity, true)
+	assert.Equal(t, "alias.com.", d.Id(), true)
+	assert.Equal(t, "", d.Get("original_zone_name"), true)
+}
+
+func TestResourceUltradnsAliasZoneOriginalNameMissing(t *testing.T) {
+	fake := newFakeUltraDNS(t, "original.com")
+	client := fake.client(t)
+
+	d := schema.TestResourceDataRaw(t, resourceUltradnsAliasZone().Schema, map[string]interface{}{
+		"name":               "alias.com.",
+		"original_zone_name": "original.com.",
+	})
+	assertNoDiagErrors(t, "create", resourceUltradnsAliasZoneCreate(context.Background(), d, client))
+
+	// a partial answer leaves out the original zone
+	fake.zones["alias.com."].zone.OriginalZoneName = ""
+	diags := resourceUltradnsAliasZoneRead(context.Background(), d, client)
+	assert.Equal(t, 0, len(diags), true)
+	assert.Equal(t, "original.com.", d.Get("original_zone_name"), true)
+
+	// as does one read for an import
+	imported := resourceUltradnsAliasZone().TestResourceData()
+	imported.SetId("alias.com.")
+	diags = resourceUltradnsAliasZoneRead(context.Background(), imported, client)
+	assert.Equal(t, 0, len(diags), true)
+	assert.Equal(t, "alias.com.", imported.Id(), true)
+	assert.Equal(t, "", imported.Get("original_zone_name"), true)
+}
+
+func TestResourceUltradnsAliasZoneReadNotAlias(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+
+	d := resourceUltradnsAliasZone().TestResourceData()
+	d.SetId("example.com.")
+	diags := resourceUltradnsAliasZoneRead(context.Background(), d, fake.client(t))
+	assert.True(t, diags.HasError(), true)
+	assert.Contains(t, diags[0].Summary, "not an alias zone", true)
+}
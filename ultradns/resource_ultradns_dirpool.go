@@ -2,35 +2,46 @@ package ultradns
 
 import (
 	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
+	"log"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/fatih/structs"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mitchellh/mapstructure"
-	log "github.com/sirupsen/logrus"
 	"github.com/ultradns/ultradns-sdk-go"
 )
 
 func resourceUltradnsDirpool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceUltradnsDirpoolCreate,
-		Read:   resourceUltradnsDirpoolRead,
-		Update: resourceUltradnsDirpoolUpdate,
-		Delete: resourceUltradnsDirpoolDelete,
+		CreateContext: resourceUltradnsDirpoolCreate,
+		ReadContext:   resourceUltradnsDirpoolRead,
+		UpdateContext: resourceUltradnsDirpoolUpdate,
+		DeleteContext: resourceUltradnsDirpoolDelete,
+
+		CustomizeDiff: customizeDiffProviderDefaults("zone", "description"),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Importer: &schema.ResourceImporter{
-			State: resourceUltradnsDirpoolImport,
+			StateContext: resourceUltradnsDirpoolImport,
 		},
 
 		Schema: map[string]*schema.Schema{
 			// Required
 			"zone": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"name": {
@@ -45,16 +56,23 @@ func resourceUltradnsDirpool() *schema.Resource {
 			},
 			"description": {
 				Type:     schema.TypeString,
-				Required: true,
-				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-					value := v.(string)
-					if len(value) > 255 {
-						errors = append(errors, fmt.Errorf(
-							"'description' too long, must be less than 255 characters"))
+				Optional: true,
+				Computed: true,
+				ValidateDiagFunc: func(v interface{}, path cty.Path) diag.Diagnostics {
+					if len(v.(string)) > 255 {
+						return diag.Diagnostics{{
+							Severity:      diag.Error,
+							Summary:       "'description' too long, must be less than 255 characters",
+							AttributePath: path,
+						}}
 					}
-					return
+					return nil
 				},
 			},
+			"account_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"rdata": {
 				// UltraDNS API does not respect rdata ordering
 				Type:     schema.TypeSet,
@@ -155,13 +173,15 @@ func resourceUltradnsDirpool() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Default:  "GEO",
-				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-					value := v.(string)
-					if value != "GEO" && value != "IP" {
-						errors = append(errors, fmt.Errorf(
-							"only 'GEO', and 'IP' are supported values for 'conflict_resolve'"))
+				ValidateDiagFunc: func(v interface{}, path cty.Path) diag.Diagnostics {
+					if value := v.(string); value != "GEO" && value != "IP" {
+						return diag.Diagnostics{{
+							Severity:      diag.Error,
+							Summary:       "only 'GEO', and 'IP' are supported values for 'conflict_resolve'",
+							AttributePath: path,
+						}}
 					}
-					return
+					return nil
 				},
 			},
 			"no_response": {
@@ -257,34 +277,39 @@ func resourceUltradnsDirpool() *schema.Resource {
 
 // CRUD Operations
 
-func resourceUltradnsDirpoolCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltradnsDirpoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 	r, err := makeDirpoolRRSetResource(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	if err := checkDirpoolAccountLevelGroups(client, d); err != nil {
+		return diag.Errorf("create failed: %v", err)
 	}
 
-	log.Printf("[INFO] ultradns_dirpool create: %#v", r)
-	_, err = client.RRSets.Create(r.RRSetKey(), r.RRSet())
+	log.Printf("[INFO] ultradns_dirpool create: %s", r.ID())
+	resp, err := client.RRSets.Create(r.RRSetKey(), r.RRSet())
 	if err != nil {
-		// FIXME: remove the json from log
-		marshalled, _ := json.Marshal(r)
-		ms := string(marshalled)
-		return fmt.Errorf("create failed: %#v [[[[ %v ]]]] -> %v", r, ms, err)
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
 	}
 
 	d.SetId(r.ID())
 	log.Printf("[INFO] ultradns_dirpool.id: %v", d.Id())
 
-	return resourceUltradnsDirpoolRead(d, meta)
+	return append(dirpoolNoResponseWarnings(d), resourceUltradnsDirpoolRead(ctx, d, meta)...)
 }
 
-func resourceUltradnsDirpoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltradnsDirpoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	rr, err := makeDirpoolRRSetResource(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	rrsets, err := client.RRSets.Select(rr.RRSetKey())
@@ -297,45 +322,62 @@ func resourceUltradnsDirpoolRead(d *schema.ResourceData, meta interface{}) error
 					d.SetId("")
 					return nil
 				}
-				return fmt.Errorf("resource not found: %v", err)
+				return diag.Errorf("resource not found: %v", err)
 			}
 		}
-		return fmt.Errorf("resource not found: %v", err)
+		return diag.Errorf("resource not found: %v", err)
 	}
 	r := rrsets[0]
 
-	return populateResourceFromDirpool(d, &r)
+	if err := populateResourceFromDirpool(d, &r); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-func resourceUltradnsDirpoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltradnsDirpoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := makeDirpoolRRSetResource(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("rdata") || d.HasChange("no_response") || d.HasChange("account_name") {
+		if err := checkDirpoolAccountLevelGroups(client, d); err != nil {
+			return diag.Errorf("resource update failed: %v", err)
+		}
 	}
 
-	log.Printf("[INFO] ultradns_dirpool update: %+v", r)
-	_, err = client.RRSets.Update(r.RRSetKey(), r.RRSet())
+	log.Printf("[INFO] ultradns_dirpool update: %s", r.ID())
+	resp, err := client.RRSets.Update(r.RRSetKey(), r.RRSet())
 	if err != nil {
-		return fmt.Errorf("resource update failed: %v", err)
+		return diag.Errorf("resource update failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("resource update failed: %v", err)
 	}
 
-	return resourceUltradnsDirpoolRead(d, meta)
+	return append(dirpoolNoResponseWarnings(d), resourceUltradnsDirpoolRead(ctx, d, meta)...)
 }
 
-func resourceUltradnsDirpoolDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltradnsDirpoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := makeDirpoolRRSetResource(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	log.Printf("[INFO] ultradns_dirpool delete: %+v", r)
-	_, err = client.RRSets.Delete(r.RRSetKey())
+	log.Printf("[INFO] ultradns_dirpool delete: %s", r.ID())
+	resp, err := client.RRSets.Delete(r.RRSetKey())
 	if err != nil {
-		return fmt.Errorf("resource delete failed: %v", err)
+		return diag.Errorf("resource delete failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("resource delete failed: %v", err)
 	}
 
 	return nil
@@ -347,7 +389,6 @@ func resourceUltradnsDirpoolDelete(d *schema.ResourceData, meta interface{}) err
 // ready for use in any CRUD operation
 func makeDirpoolRRSetResource(d *schema.ResourceData) (rRSetResource, error) {
 	rDataRaw := d.Get("rdata").(*schema.Set).List()
-	log.Infof("dataset : %+v", d.Get("rdata").(*schema.Set).List())
 
 	res := rRSetResource{
 		RRType:    d.Get("type").(string),
@@ -381,10 +422,32 @@ func makeDirpoolRRSetResource(d *schema.ResourceData) (rRSetResource, error) {
 	}
 
 	res.Profile = profile.RawProfile()
-	log.Infof("res: %+v", res)
 	return res, nil
 }
 
+// dirpoolNoResponseWarnings warns about a no_response block that names no
+// territory, IP range or all_non_configured. Such a block matches no
+// request, so the pool answers as if it were not there.
+func dirpoolNoResponseWarnings(d *schema.ResourceData) diag.Diagnostics {
+	noResponseRaw := d.Get("no_response").([]interface{})
+	if len(noResponseRaw) != 1 {
+		return nil
+	}
+	if nr, ok := noResponseRaw[0].(map[string]interface{}); ok {
+		if nr["all_non_configured"].(bool) ||
+			len(nr["geo_info"].([]interface{})) > 0 ||
+			len(nr["ip_info"].([]interface{})) > 0 {
+			return nil
+		}
+	}
+	return diag.Diagnostics{{
+		Severity:      diag.Warning,
+		Summary:       "no_response is ignored",
+		Detail:        "The no_response block sets neither all_non_configured, geo_info nor ip_info, so no request is left unanswered.",
+		AttributePath: cty.GetAttrPath("no_response").IndexInt(0),
+	}}
+}
+
 // populateResourceFromDirpool takes an RRSet and populates the ResourceData
 func populateResourceFromDirpool(d *schema.ResourceData, r *udnssdk.RRSet) error {
 	// TODO: fix from tcpool to dirpool
@@ -428,7 +491,6 @@ func populateResourceFromDirpool(d *schema.ResourceData, r *udnssdk.RRSet) error
 	} else {
 		d.Set("conflict_resolve", p.ConflictResolve)
 	}
-	log.Infof("r.RData= %v and p.RDataInfo= %v", r.RData, p.RDataInfo)
 	rd := makeSetFromDirpoolRdata(r.RData, p.RDataInfo)
 	err = d.Set("rdata", rd)
 	if err != nil {
@@ -486,21 +548,23 @@ func makeDirpoolRdataInfoAPI(configured interface{}) (udnssdk.DPRDataInfo, error
 		AllNonConfigured: allNonConfigured,
 	}
 
-	// IPInfo
-	ipInfo := data["ipInfo"].(interface{})
-	ii, err := makeIPInfo(ipInfo)
-	if err != nil {
-		return res, fmt.Errorf("%v ip_info: %#v", err, ii)
+	// IPInfo, absent when only territories get no response
+	if ipInfo, ok := data["ipInfo"]; ok && ipInfo != nil {
+		ii, err := makeIPInfo(ipInfo)
+		if err != nil {
+			return res, fmt.Errorf("%v ip_info: %#v", err, ii)
+		}
+		res.IPInfo = &ii
 	}
-	res.IPInfo = &ii
 
-	// GeoInfo
-	geoInfo := data["geoInfo"].(interface{})
-	gi, err := makeGeoInfo(geoInfo)
-	if err != nil {
-		return res, fmt.Errorf("%v geo_info: %#v GeoInfo: %#v", err, geoInfo, gi)
+	// GeoInfo, absent when only IP ranges get no response
+	if geoInfo, ok := data["geoInfo"]; ok && geoInfo != nil {
+		gi, err := makeGeoInfo(geoInfo)
+		if err != nil {
+			return res, fmt.Errorf("%v geo_info: %#v GeoInfo: %#v", err, geoInfo, gi)
+		}
+		res.GeoInfo = &gi
 	}
-	res.GeoInfo = &gi
 
 	return res, nil
 }
@@ -581,17 +645,22 @@ func makeIPInfo(configured interface{}) (udnssdk.IPInfo, error) {
 		rawIps = c["ips"].(*schema.Set).List()
 	}
 
-	res.Ips = make([]udnssdk.IPAddrDTO, 0, len(rawIps))
+	res.Ips, err = makeIPAddrDTOs(rawIps)
+	return res, err
+}
 
+// makeIPAddrDTOs converts ips blocks into IPAddrDTOs
+func makeIPAddrDTOs(rawIps []interface{}) ([]udnssdk.IPAddrDTO, error) {
+	ips := make([]udnssdk.IPAddrDTO, 0, len(rawIps))
 	for _, rawIa := range rawIps {
 		var i udnssdk.IPAddrDTO
-		err = mapDecode(rawIa, &i)
+		err := mapDecode(rawIa, &i)
 		if err != nil {
-			return res, err
+			return ips, err
 		}
-		res.Ips = append(res.Ips, i)
+		ips = append(ips, i)
 	}
-	return res, nil
+	return ips, nil
 }
 
 // collate and zip RData and RDataInfo into []map[string]interface{}
@@ -667,7 +736,7 @@ func hashIPInfoIPs(v interface{}) int {
 	buf.WriteString(fmt.Sprintf("%s-", m["cidr"].(string)))
 	buf.WriteString(fmt.Sprintf("%s", m["address"].(string)))
 
-	h := hashcode.String(buf.String())
+	h := schema.HashString(buf.String())
 	log.Printf("[DEBUG] hashIPInfoIPs(): %v -> %v", buf.String(), h)
 	return h
 }
@@ -709,6 +778,6 @@ func mapEncode(rawVal interface{}) map[string]interface{} {
 
 // State Function to seperate id into appropriate name and zone
 func resourceUltradnsDirpoolImport(
-	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	return setResourceAndParseId(d)
 }
@@ -0,0 +1,203 @@
+package ultradns
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/ultradns/ultradns-sdk-go"
+)
+
+func resourceUltradnsDirpoolGeoGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUltradnsDirpoolGeoGroupCreate,
+		ReadContext:   resourceUltradnsDirpoolGeoGroupRead,
+		UpdateContext: resourceUltradnsDirpoolGeoGroupUpdate,
+		DeleteContext: resourceUltradnsDirpoolGeoGroupDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUltradnsDirpoolGeoGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringIsNotWhiteSpace,
+					validation.StringDoesNotContainAny("/"),
+				),
+			},
+			"codes": {
+				Type:     schema.TypeSet,
+				Set:      schema.HashString,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// Optional
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"account_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressAccountNameCase,
+			},
+		},
+	}
+}
+
+// CRUD Operations
+
+func resourceUltradnsDirpoolGeoGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	k, err := makeGeoDirectionalPoolKey(client, d)
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	log.Printf("[INFO] ultradns_dirpool_geo_group create: %s in %s", k.Name, k.Account)
+	resp, err := client.DirectionalPools.Geos().Create(k, makeGeoDirectionalGroupDTO(d))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	d.SetId(k.Name)
+	d.Set("account_name", string(k.Account))
+	log.Printf("[INFO] ultradns_dirpool_geo_group.id: %v", d.Id())
+
+	return resourceUltradnsDirpoolGeoGroupRead(ctx, d, meta)
+}
+
+func resourceUltradnsDirpoolGeoGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	k, err := makeGeoDirectionalPoolKey(client, d)
+	if err != nil {
+		return diag.Errorf("read failed: %v", err)
+	}
+
+	log.Printf("[DEBUG] ultradns_dirpool_geo_group read: %s in %s", k.Name, k.Account)
+	g, _, err := client.DirectionalPools.Geos().Find(k)
+	if err != nil {
+		// 70002 means Data Not Found
+		if isNotFound(err) {
+			log.Printf("[WARN] ultradns_dirpool_geo_group %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("not found: %v", err)
+	}
+
+	d.Set("account_name", string(k.Account))
+	populateResourceDataFromGeoDirectionalGroup(g, d)
+	return nil
+}
+
+func resourceUltradnsDirpoolGeoGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	k, err := makeGeoDirectionalPoolKey(client, d)
+	if err != nil {
+		return diag.Errorf("update failed: %v", err)
+	}
+
+	log.Printf("[INFO] ultradns_dirpool_geo_group update: %s in %s", k.Name, k.Account)
+	resp, err := client.DirectionalPools.Geos().Update(k, makeGeoDirectionalGroupDTO(d))
+	if err != nil {
+		return diag.Errorf("update failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("update failed: %v", err)
+	}
+
+	return resourceUltradnsDirpoolGeoGroupRead(ctx, d, meta)
+}
+
+func resourceUltradnsDirpoolGeoGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	k, err := makeGeoDirectionalPoolKey(client, d)
+	if err != nil {
+		return diag.Errorf("delete failed: %v", err)
+	}
+
+	log.Printf("[INFO] ultradns_dirpool_geo_group delete: %s in %s", k.Name, k.Account)
+	resp, err := client.DirectionalPools.Geos().Delete(k)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("delete failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("delete failed: %v", err)
+	}
+
+	return nil
+}
+
+// State function to take the account and group name from the ID
+func resourceUltradnsDirpoolGeoGroupImport(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return setDirGroupResourceAndParseId(d)
+}
+
+// Resource Helpers
+
+// makeGeoDirectionalPoolKey returns the key of the group in the account
+// it belongs to
+func makeGeoDirectionalPoolKey(client *Client, d *schema.ResourceData) (udnssdk.GeoDirectionalPoolKey, error) {
+	account, err := client.account(d.Get("account_name").(string))
+	if err != nil {
+		return udnssdk.GeoDirectionalPoolKey{}, err
+	}
+	name := d.Id()
+	if name == "" {
+		name = d.Get("name").(string)
+	}
+	return udnssdk.GeoDirectionalPoolKey{Account: udnssdk.AccountKey(account), Name: name}, nil
+}
+
+func makeGeoDirectionalGroupDTO(d *schema.ResourceData) udnssdk.AccountLevelGeoDirectionalGroupDTO {
+	codes := []string{}
+	for _, c := range d.Get("codes").(*schema.Set).List() {
+		codes = append(codes, c.(string))
+	}
+	sort.Strings(codes)
+	return udnssdk.AccountLevelGeoDirectionalGroupDTO{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Codes:       codes,
+	}
+}
+
+func populateResourceDataFromGeoDirectionalGroup(g udnssdk.AccountLevelGeoDirectionalGroupDTO, d *schema.ResourceData) {
+	d.Set("name", g.Name)
+	d.Set("description", g.Description)
+	d.Set("codes", makeSetFromStrings(g.Codes))
+}
@@ -0,0 +1,120 @@
+package ultradns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceUltradnsDirpoolGeoGroupImport(t *testing.T) {
+	d := resourceUltradnsDirpoolGeoGroup().TestResourceData()
+	d.SetId("Europe West")
+	imported, err := resourceUltradnsDirpoolGeoGroupImport(context.Background(), d, &Client{})
+	assert.Nil(t, err, true)
+	assert.Equal(t, "Europe West", imported[0].Get("name"), true)
+	assert.Equal(t, "", imported[0].Get("account_name"), true)
+
+	d = resourceUltradnsDirpoolGeoGroup().TestResourceData()
+	d.SetId("other-account:Europe West")
+	imported, err = resourceUltradnsDirpoolGeoGroupImport(context.Background(), d, &Client{})
+	assert.Nil(t, err, true)
+	assert.Equal(t, "Europe West", imported[0].Id(), true)
+	assert.Equal(t, "Europe West", imported[0].Get("name"), true)
+	assert.Equal(t, "other-account", imported[0].Get("account_name"), true)
+
+	for _, id := range []string{"", " ", "geo/Europe", "other-account:", ":Europe West", "other-account: "} {
+		d.SetId(id)
+		_, err = resourceUltradnsDirpoolGeoGroupImport(context.Background(), d, &Client{})
+		assert.NotNil(t, err, id)
+	}
+}
+
+func TestResourceUltradnsDirpoolGeoGroupLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t)
+	testResourceLifecycle(t, fake, resourceUltradnsDirpoolGeoGroup(),
+		map[string]interface{}{
+			"name":  "EU",
+			"codes": []interface{}{"DE", "FR"},
+		},
+		map[string]interface{}{
+			"name":         "EU",
+			"account_name": fakeAccount,
+			"description":  "European Union",
+			"codes":        []interface{}{"DE", "FR", "NL"},
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			g, ok := fake.dirGroup("geo", "EU")
+			assert.True(t, ok, true)
+			assert.Equal(t, "European Union", g["description"], true)
+			assert.Equal(t, []interface{}{"DE", "FR", "NL"}, g["codes"], true)
+			assert.Equal(t, 3, d.Get("codes").(*schema.Set).Len(), true)
+		})
+}
+
+func TestResourceUltradnsDirpoolGeoGroupImportAccount(t *testing.T) {
+	fake := newFakeUltraDNS(t)
+	client := fake.client(t)
+	ctx := context.Background()
+	res := resourceUltradnsDirpoolGeoGroup()
+
+	raw := map[string]interface{}{
+		"name":         "EU",
+		"account_name": "Terraform-Account",
+		"codes":        []interface{}{"DE", "FR"},
+	}
+	assertNoDiagErrors(t, "create", res.CreateContext(ctx, schema.TestResourceDataRaw(t, res.Schema, raw), client))
+
+	d := res.TestResourceData()
+	d.SetId("Terraform-Account:EU")
+	imported, err := res.Importer.StateContext(ctx, d, client)
+	assert.Nil(t, err, true)
+	assertNoDiagErrors(t, "read", res.ReadContext(ctx, imported[0], client))
+	assert.Equal(t, "EU", imported[0].Id(), true)
+	assert.Equal(t, fakeAccount, imported[0].Get("account_name"), true)
+	assert.Equal(t, 2, imported[0].Get("codes").(*schema.Set).Len(), true)
+
+	// The configured spelling of the account does not replace the group
+	diff, err := res.Diff(ctx, imported[0].State(), terraform.NewResourceConfigRaw(raw), client)
+	assert.Nil(t, err, true)
+	if diff != nil {
+		assert.False(t, diff.RequiresNew(), true)
+	}
+}
+
+func TestResourceUltradnsDirpoolGeoGroupInDirpool(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	client := fake.client(t)
+	ctx := context.Background()
+
+	group := resourceUltradnsDirpoolGeoGroup()
+	g := schema.TestResourceDataRaw(t, group.Schema, map[string]interface{}{
+		"name":  "APAC",
+		"codes": []interface{}{"JP", "SG", "AU"},
+	})
+	assertNoDiagErrors(t, "create group", group.CreateContext(ctx, g, client))
+
+	dirpool := func(name string) *schema.ResourceData {
+		return schema.TestResourceDataRaw(t, resourceUltradnsDirpool().Schema, map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "dirpool",
+			"type":        "A",
+			"description": "directional",
+			"rdata": []interface{}{
+				map[string]interface{}{
+					"host": "10.0.0.1",
+					"geo_info": []interface{}{
+						map[string]interface{}{"name": name, "is_account_level": true},
+					},
+				},
+			},
+		})
+	}
+	assert.Nil(t, checkDirpoolAccountLevelGroups(client, dirpool("APAC")), true)
+	assert.NotNil(t, checkDirpoolAccountLevelGroups(client, dirpool("EMEA")), true)
+
+	assertNoDiagErrors(t, "delete group", group.DeleteContext(ctx, g, client))
+	assert.NotNil(t, checkDirpoolAccountLevelGroups(client, dirpool("APAC")), true)
+}
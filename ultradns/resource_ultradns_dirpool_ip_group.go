@@ -0,0 +1,281 @@
+package ultradns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/ultradns/ultradns-sdk-go"
+)
+
+func resourceUltradnsDirpoolIPGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUltradnsDirpoolIPGroupCreate,
+		ReadContext:   resourceUltradnsDirpoolIPGroupRead,
+		UpdateContext: resourceUltradnsDirpoolIPGroupUpdate,
+		DeleteContext: resourceUltradnsDirpoolIPGroupDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUltradnsDirpoolIPGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringIsNotWhiteSpace,
+					validation.StringDoesNotContainAny("/"),
+				),
+			},
+			"ips": {
+				Type:     schema.TypeSet,
+				Set:      hashIPInfoIPs,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"cidr": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			// Optional
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"account_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressAccountNameCase,
+			},
+		},
+	}
+}
+
+// CRUD Operations
+
+func resourceUltradnsDirpoolIPGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	k, err := makeIPDirectionalPoolKey(client, d)
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	g, err := makeIPDirectionalGroupDTO(d)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Could not load ultradns_dirpool_ip_group configuration",
+			Detail:        err.Error(),
+			AttributePath: cty.GetAttrPath("ips"),
+		}}
+	}
+
+	log.Printf("[INFO] ultradns_dirpool_ip_group create: %s in %s", k.Name, k.Account)
+	resp, err := client.DirectionalPools.IPs().Create(k, g)
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	d.SetId(k.Name)
+	d.Set("account_name", string(k.Account))
+	log.Printf("[INFO] ultradns_dirpool_ip_group.id: %v", d.Id())
+
+	return resourceUltradnsDirpoolIPGroupRead(ctx, d, meta)
+}
+
+func resourceUltradnsDirpoolIPGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	k, err := makeIPDirectionalPoolKey(client, d)
+	if err != nil {
+		return diag.Errorf("read failed: %v", err)
+	}
+
+	log.Printf("[DEBUG] ultradns_dirpool_ip_group read: %s in %s", k.Name, k.Account)
+	g, _, err := client.DirectionalPools.IPs().Find(k)
+	if err != nil {
+		// 70002 means Data Not Found
+		if isNotFound(err) {
+			log.Printf("[WARN] ultradns_dirpool_ip_group %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("not found: %v", err)
+	}
+
+	d.Set("account_name", string(k.Account))
+	populateResourceDataFromIPDirectionalGroup(g, d)
+	return nil
+}
+
+func resourceUltradnsDirpoolIPGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	k, err := makeIPDirectionalPoolKey(client, d)
+	if err != nil {
+		return diag.Errorf("update failed: %v", err)
+	}
+
+	g, err := makeIPDirectionalGroupDTO(d)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Could not load ultradns_dirpool_ip_group configuration",
+			Detail:        err.Error(),
+			AttributePath: cty.GetAttrPath("ips"),
+		}}
+	}
+
+	log.Printf("[INFO] ultradns_dirpool_ip_group update: %s in %s", k.Name, k.Account)
+	resp, err := client.DirectionalPools.IPs().Update(k, g)
+	if err != nil {
+		return diag.Errorf("update failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("update failed: %v", err)
+	}
+
+	return resourceUltradnsDirpoolIPGroupRead(ctx, d, meta)
+}
+
+func resourceUltradnsDirpoolIPGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	k, err := makeIPDirectionalPoolKey(client, d)
+	if err != nil {
+		return diag.Errorf("delete failed: %v", err)
+	}
+
+	log.Printf("[INFO] ultradns_dirpool_ip_group delete: %s in %s", k.Name, k.Account)
+	resp, err := client.DirectionalPools.IPs().Delete(k)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("delete failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("delete failed: %v", err)
+	}
+
+	return nil
+}
+
+// State function to take the account and group name from the ID
+func resourceUltradnsDirpoolIPGroupImport(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return setDirGroupResourceAndParseId(d)
+}
+
+// Resource Helpers
+
+// makeIPDirectionalPoolKey returns the key of the group in the account
+// it belongs to
+func makeIPDirectionalPoolKey(client *Client, d *schema.ResourceData) (udnssdk.IPDirectionalPoolKey, error) {
+	account, err := client.account(d.Get("account_name").(string))
+	if err != nil {
+		return udnssdk.IPDirectionalPoolKey{}, err
+	}
+	name := d.Id()
+	if name == "" {
+		name = d.Get("name").(string)
+	}
+	return udnssdk.IPDirectionalPoolKey{Account: udnssdk.AccountKey(account), Name: name}, nil
+}
+
+func makeIPDirectionalGroupDTO(d *schema.ResourceData) (udnssdk.AccountLevelIPDirectionalGroupDTO, error) {
+	rawIps := d.Get("ips").(*schema.Set).List()
+	for _, raw := range rawIps {
+		if err := checkIPGroupEntry(raw); err != nil {
+			return udnssdk.AccountLevelIPDirectionalGroupDTO{}, err
+		}
+	}
+	ips, err := makeIPAddrDTOs(rawIps)
+	return udnssdk.AccountLevelIPDirectionalGroupDTO{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		IPs:         ips,
+	}, err
+}
+
+// checkIPGroupEntry makes sure an ips entry sets exactly one of a start
+// and end range, a cidr or an address, which the API otherwise only
+// rejects during the rollout.
+func checkIPGroupEntry(raw interface{}) error {
+	ip, _ := raw.(map[string]interface{})
+	get := func(k string) string {
+		v, _ := ip[k].(string)
+		return v
+	}
+
+	set := []string{}
+	for _, k := range []string{"start", "end", "cidr", "address"} {
+		if v := get(k); v != "" {
+			set = append(set, fmt.Sprintf("%s = %q", k, v))
+		}
+	}
+	start, end := get("start"), get("end")
+	kinds := 0
+	for _, v := range []string{start + end, get("cidr"), get("address")} {
+		if v != "" {
+			kinds++
+		}
+	}
+
+	switch {
+	case kinds == 0:
+		return fmt.Errorf("an ips entry sets none of start and end, cidr or address; set exactly one")
+	case kinds > 1:
+		return fmt.Errorf("ips entry {%s} sets more than one of start and end, cidr or address; set exactly one", strings.Join(set, ", "))
+	case (start == "") != (end == ""):
+		return fmt.Errorf("ips entry {%s} must set both start and end", strings.Join(set, ", "))
+	}
+	return nil
+}
+
+func populateResourceDataFromIPDirectionalGroup(g udnssdk.AccountLevelIPDirectionalGroupDTO, d *schema.ResourceData) {
+	d.Set("name", g.Name)
+	d.Set("description", g.Description)
+	d.Set("ips", makeSetFromIPAddrDTOs(g.IPs))
+}
@@ -0,0 +1,139 @@
+package ultradns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceUltradnsDirpoolIPGroupImport(t *testing.T) {
+	d := resourceUltradnsDirpoolIPGroup().TestResourceData()
+	d.SetId("office")
+	imported, err := resourceUltradnsDirpoolIPGroupImport(context.Background(), d, &Client{})
+	assert.Nil(t, err, true)
+	assert.Equal(t, "office", imported[0].Get("name"), true)
+
+	d = resourceUltradnsDirpoolIPGroup().TestResourceData()
+	d.SetId("other-account:office")
+	imported, err = resourceUltradnsDirpoolIPGroupImport(context.Background(), d, &Client{})
+	assert.Nil(t, err, true)
+	assert.Equal(t, "office", imported[0].Id(), true)
+	assert.Equal(t, "office", imported[0].Get("name"), true)
+	assert.Equal(t, "other-account", imported[0].Get("account_name"), true)
+
+	for _, id := range []string{"ip/office", "other-account:", ":office"} {
+		d.SetId(id)
+		_, err = resourceUltradnsDirpoolIPGroupImport(context.Background(), d, &Client{})
+		assert.NotNil(t, err, id)
+	}
+}
+
+func TestMakeIPDirectionalGroupDTOEntries(t *testing.T) {
+	cases := []struct {
+		ip  map[string]interface{}
+		err string
+	}{
+		{map[string]interface{}{"start": "10.0.0.1", "end": "10.0.0.9"}, ""},
+		{map[string]interface{}{"cidr": "10.0.0.0/24"}, ""},
+		{map[string]interface{}{"address": "10.0.0.1"}, ""},
+		{map[string]interface{}{"cidr": ""}, "sets none of start and end, cidr or address"},
+		{map[string]interface{}{"cidr": "10.0.0.0/24", "address": "10.0.0.1"}, `ips entry {cidr = "10.0.0.0/24", address = "10.0.0.1"} sets more than one`},
+		{map[string]interface{}{"start": "10.0.0.1", "cidr": "10.0.0.0/24"}, `ips entry {start = "10.0.0.1", cidr = "10.0.0.0/24"} sets more than one`},
+		{map[string]interface{}{"start": "10.0.0.1"}, `ips entry {start = "10.0.0.1"} must set both start and end`},
+	}
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, resourceUltradnsDirpoolIPGroup().Schema, map[string]interface{}{
+			"name": "office",
+			"ips":  []interface{}{c.ip},
+		})
+		_, err := makeIPDirectionalGroupDTO(d)
+		if c.err == "" {
+			assert.Nil(t, err, c.ip)
+		} else if assert.NotNil(t, err, c.ip) {
+			assert.Contains(t, err.Error(), c.err, c.ip)
+		}
+	}
+}
+
+func TestResourceUltradnsDirpoolIPGroupInvalidEntry(t *testing.T) {
+	fake := newFakeUltraDNS(t)
+	res := resourceUltradnsDirpoolIPGroup()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name": "office",
+		"ips": []interface{}{
+			map[string]interface{}{"cidr": "10.0.0.0/24", "address": "10.0.0.1"},
+		},
+	})
+	diags := res.CreateContext(context.Background(), d, fake.client(t))
+	assert.True(t, diags.HasError(), true)
+	assert.Equal(t, cty.GetAttrPath("ips"), diags[0].AttributePath, true)
+	_, ok := fake.dirGroup("ip", "office")
+	assert.False(t, ok, true)
+}
+
+func TestResourceUltradnsDirpoolIPGroupLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t)
+	testResourceLifecycle(t, fake, resourceUltradnsDirpoolIPGroup(),
+		map[string]interface{}{
+			"name": "office",
+			"ips": []interface{}{
+				map[string]interface{}{"cidr": "192.0.2.0/24"},
+			},
+		},
+		map[string]interface{}{
+			"name":        "office",
+			"description": "Office, VPN and partner ranges",
+			"ips": []interface{}{
+				map[string]interface{}{"cidr": "192.0.2.0/24"},
+				map[string]interface{}{"start": "198.51.100.10", "end": "198.51.100.20"},
+				map[string]interface{}{"address": "203.0.113.7"},
+			},
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			g, ok := fake.dirGroup("ip", "office")
+			assert.True(t, ok, true)
+			assert.ElementsMatch(t, []interface{}{
+				map[string]interface{}{"cidr": "192.0.2.0/24"},
+				map[string]interface{}{"start": "198.51.100.10", "end": "198.51.100.20"},
+				map[string]interface{}{"address": "203.0.113.7"},
+			}, g["ips"], true)
+			assert.Equal(t, 3, d.Get("ips").(*schema.Set).Len(), true)
+		})
+}
+
+func TestResourceUltradnsDirpoolIPGroupInDirpool(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	client := fake.client(t)
+	ctx := context.Background()
+
+	group := resourceUltradnsDirpoolIPGroup()
+	g := schema.TestResourceDataRaw(t, group.Schema, map[string]interface{}{
+		"name": "vpn",
+		"ips": []interface{}{
+			map[string]interface{}{"cidr": "10.8.0.0/16"},
+		},
+	})
+	assertNoDiagErrors(t, "create group", group.CreateContext(ctx, g, client))
+
+	dirpool := func(name string) *schema.ResourceData {
+		return schema.TestResourceDataRaw(t, resourceUltradnsDirpool().Schema, map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "dirpool",
+			"type":        "A",
+			"description": "directional",
+			"rdata": []interface{}{
+				map[string]interface{}{
+					"host": "10.0.0.1",
+					"ip_info": []interface{}{
+						map[string]interface{}{"name": name, "is_account_level": true},
+					},
+				},
+			},
+		})
+	}
+	assert.Nil(t, checkDirpoolAccountLevelGroups(client, dirpool("vpn")), true)
+	assert.NotNil(t, checkDirpoolAccountLevelGroups(client, dirpool("partners")), true)
+}
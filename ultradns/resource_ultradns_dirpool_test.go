@@ -1,6 +1,7 @@
 package ultradns
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -8,9 +9,11 @@ import (
 	_ "reflect"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	udnssdk "github.com/ultradns/ultradns-sdk-go"
@@ -89,7 +92,8 @@ func setResourceRecordDirPool() (resourceRecord *schema.Resource) {
 			// Required
 			"zone": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"name": {
@@ -104,7 +108,8 @@ func setResourceRecordDirPool() (resourceRecord *schema.Resource) {
 			},
 			"description": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 					value := v.(string)
 					if len(value) > 255 {
@@ -114,6 +119,10 @@ func setResourceRecordDirPool() (resourceRecord *schema.Resource) {
 					return
 				},
 			},
+			"account_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"rdata": {
 				// UltraDNS API does not respect rdata ordering
 				Type:     schema.TypeSet,
@@ -570,9 +579,9 @@ func TestResourceUltradnsDirPoolCreate(t *testing.T) {
 	actualData := resourceRecordObject.TestResourceData()
 	mocked := mockUltraDNSRecordDirPool{}
 
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 
 	rrsetDTO := make([]map[string]interface{}, 1)
 	noResponseDTO := make([]map[string]interface{}, 1)
@@ -642,7 +651,7 @@ func TestResourceUltradnsDirPoolCreate(t *testing.T) {
 	actualData.Set("description", "testing")
 	actualData.Set("hostname", "test.provider.ultradns.net.test.provider.ultradns.net")
 
-	resourceUltradnsDirpoolCreate(actualData, client)
+	resourceUltradnsDirpoolCreate(context.Background(), actualData, client)
 	compareDirPoolResources(t, expectedData, actualData)
 
 }
@@ -654,9 +663,9 @@ func TestResourceUltradnsDirPoolRead(t *testing.T) {
 	actualData := resourceRecordObject.TestResourceData()
 	mocked := mockUltraDNSRecordDirPool{}
 
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 
 	rrsetDTO := make([]map[string]interface{}, 1)
 	noResponseDTO := make([]map[string]interface{}, 1)
@@ -720,7 +729,7 @@ func TestResourceUltradnsDirPoolRead(t *testing.T) {
 	actualData.Set("name", "test.provider.ultradns.net")
 	actualData.Set("zone", "test.provider.ultradns.net")
 
-	resourceUltradnsDirpoolRead(actualData, client)
+	resourceUltradnsDirpoolRead(context.Background(), actualData, client)
 	compareDirPoolResources(t, expectedData, actualData)
 
 }
@@ -732,9 +741,9 @@ func TestResourceUltradnsDirPoolUpdate(t *testing.T) {
 	actualData := resourceRecordObject.TestResourceData()
 	mocked := mockUltraDNSRecordDirPool{}
 
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 
 	rrsetDTO := make([]map[string]interface{}, 1)
 	noResponseDTO := make([]map[string]interface{}, 1)
@@ -804,7 +813,7 @@ func TestResourceUltradnsDirPoolUpdate(t *testing.T) {
 	actualData.Set("description", "testing")
 	actualData.Set("hostname", "test.provider.ultradns.net.test.provider.ultradns.net")
 
-	resourceUltradnsDirpoolUpdate(actualData, client)
+	resourceUltradnsDirpoolUpdate(context.Background(), actualData, client)
 	compareDirPoolResources(t, expectedData, actualData)
 
 }
@@ -816,9 +825,9 @@ func TestResourceUltradnsDirPoolDelete(t *testing.T) {
 	actualData := resourceRecordObject.TestResourceData()
 	mocked := mockUltraDNSRecordDirPool{}
 
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 
 	rrsetDTO := make([]map[string]interface{}, 1)
 	noResponseDTO := make([]map[string]interface{}, 1)
@@ -888,20 +897,20 @@ func TestResourceUltradnsDirPoolDelete(t *testing.T) {
 	actualData.Set("description", "testing")
 	actualData.Set("hostname", "test.provider.ultradns.net.test.provider.ultradns.net")
 
-	resourceUltradnsDirpoolDelete(actualData, client)
+	resourceUltradnsDirpoolDelete(context.Background(), actualData, client)
 	compareDirPoolResources(t, expectedData, actualData)
 
 }
 
 func TestResourceUltradnsDirPoolImport(t *testing.T) {
 	mocked := mockUltraDNSRecordRDPool{}
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 	resourceRecordObj := setResourceRecordDirPool()
 	d := resourceRecordObj.TestResourceData()
 	d.SetId("test:test.provider.ultradns.net:A")
-	newRecordData, _ := resourceUltradnsDirpoolImport(d, client)
+	newRecordData, _ := resourceUltradnsDirpoolImport(context.Background(), d, client)
 	assert.Equal(t, newRecordData[0].Get("name"), "test", true)
 	assert.Equal(t, newRecordData[0].Get("zone"), "test.provider.ultradns.net", true)
 	assert.Equal(t, newRecordData[0].Get("type"), "A", true)
@@ -911,13 +920,13 @@ func TestResourceUltradnsDirPoolImport(t *testing.T) {
 //Testcase to check fail case
 func TestResourceUltradnsDirPoolImportFailCase(t *testing.T) {
 	mocked := mockUltraDNSRecordDirPool{}
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 	resourceRecordObj := setResourceRecordDirPool()
 	d := resourceRecordObj.TestResourceData()
 	d.SetId("testabc.test.provider.ultradns.net")
-	_, err := resourceUltradnsDirpoolImport(d, client)
+	_, err := resourceUltradnsDirpoolImport(context.Background(), d, client)
 	log.Errorf("Error: %+v", err)
 	assert.NotNil(t, err, true)
 
@@ -937,15 +946,33 @@ func TestResourceUltradnsDirPoolFailCases(t *testing.T) {
 	actualData.Set("description", "xWVQfy7AtNcCATHLSNppqs2SjImlnYOBi2UVp9X5XlEzoRCkmttmb2tD2JZI7AW4cySeS9aOvSFOj0oZM8m78cExZtnIO8dTeilKp6iObO1ipB2g4966c630QBxsHotCqEjrQ8Ky70vw3hd6mL16qe9nuHr8BDxJ4LYm5OyyiMT85NSuA0PykDl1hJhL5t6pCuPYqQQ8tXuLBqArJBZGuoPIPQHHLf33aSASRuVPkKZ8wqgJFLz4zgJ8mUEtIc9TmBRsddadsdadasdsdasdsDasdasdaDADADwadaDAWDASDADSDDADDWDAWDASDWADDADWADWADAWDW")
 
 	mocked := mockUltraDNSRecordRDPool{}
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
-	err := resourceUltradnsDirpoolCreate(actualData, client)
+	}}
+	err := resourceUltradnsDirpoolCreate(context.Background(), actualData, client)
 	log.Infof("Error : %+v", err)
 	assert.NotNil(t, err, true)
 
 }
 
+func TestDirpoolNoResponseWarnings(t *testing.T) {
+	resourceData := setResourceRecordDirPool().TestResourceData()
+	assert.Empty(t, dirpoolNoResponseWarnings(resourceData), true)
+
+	resourceData.Set("no_response", []interface{}{
+		map[string]interface{}{"all_non_configured": false},
+	})
+	diags := dirpoolNoResponseWarnings(resourceData)
+	assert.Len(t, diags, 1, true)
+	assert.Equal(t, diag.Warning, diags[0].Severity, true)
+	assert.Equal(t, cty.GetAttrPath("no_response").IndexInt(0), diags[0].AttributePath, true)
+
+	resourceData.Set("no_response", []interface{}{
+		map[string]interface{}{"all_non_configured": true},
+	})
+	assert.Empty(t, dirpoolNoResponseWarnings(resourceData), true)
+}
+
 func TestMakeDirpoolRRSetResourceFailCases(t *testing.T) {
 
 	resourceRecordObj := setResourceRecordDirPool()
@@ -1372,6 +1399,101 @@ func TestMakeDirpoolRdataInfoAPIFailCase(t *testing.T) {
 	assert.Equal(t, expectedError, err, true)
 
 }
+
+func TestMakeDirpoolRdataInfoAPIWithoutInfo(t *testing.T) {
+	// Rdata that only answers territories, or only IP ranges, comes
+	// without the other block
+	rrsetDTO := make([]map[string]interface{}, 2)
+	data := []byte(`
+	[{
+		"allNonConfigured": false,
+		"geoInfo": {
+			"name": "North America",
+			"codes": ["US"]
+		}
+	},
+	{
+		"allNonConfigured": true,
+		"ipInfo": null
+	}]`)
+	err := json.Unmarshal(data, &rrsetDTO)
+	assert.Nil(t, err, true)
+
+	ri, err := makeDirpoolRdataInfoAPI(rrsetDTO[0])
+	assert.Nil(t, err, true)
+	assert.Nil(t, ri.IPInfo, true)
+	assert.Equal(t, "North America", ri.GeoInfo.Name, true)
+
+	ri, err = makeDirpoolRdataInfoAPI(rrsetDTO[1])
+	assert.Nil(t, err, true)
+	assert.Nil(t, ri.IPInfo, true)
+	assert.Nil(t, ri.GeoInfo, true)
+	assert.True(t, ri.AllNonConfigured, true)
+}
+
+func TestResourceUltradnsDirPoolLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	testResourceLifecycle(t, fake, resourceUltradnsDirpool(),
+		map[string]interface{}{
+			"zone":             "example.com",
+			"name":             "dirpool",
+			"type":             "A",
+			"description":      "directional",
+			"conflict_resolve": "GEO",
+			"rdata": []interface{}{
+				map[string]interface{}{
+					"host":               "10.0.0.1",
+					"all_non_configured": true,
+				},
+				map[string]interface{}{
+					"host": "10.0.0.2",
+					"geo_info": []interface{}{
+						map[string]interface{}{"name": "North America", "codes": []interface{}{"US", "CA"}},
+					},
+				},
+			},
+		},
+		map[string]interface{}{
+			"zone":             "example.com",
+			"name":             "dirpool",
+			"type":             "A",
+			"description":      "directional, by source IP",
+			"conflict_resolve": "IP",
+			"rdata": []interface{}{
+				map[string]interface{}{
+					"host":               "10.0.0.1",
+					"all_non_configured": true,
+				},
+				map[string]interface{}{
+					"host": "10.0.0.3",
+					"ip_info": []interface{}{
+						map[string]interface{}{
+							"name": "office",
+							"ips": []interface{}{
+								map[string]interface{}{"cidr": "192.0.2.0/24"},
+							},
+						},
+					},
+				},
+			},
+			"no_response": []interface{}{
+				map[string]interface{}{
+					"geo_info": []interface{}{
+						map[string]interface{}{"name": "Antarctica", "codes": []interface{}{"AQ"}},
+					},
+				},
+			},
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			rrset, ok := fake.rrset("example.com", "A", "dirpool")
+			assert.True(t, ok, true)
+			assert.ElementsMatch(t, []string{"10.0.0.1", "10.0.0.3"}, rrset.RData, true)
+			assert.Equal(t, "IP", rrset.Profile["conflictResolve"], true)
+			assert.NotNil(t, rrset.Profile["noResponse"], true)
+			assert.Len(t, d.Get("no_response").([]interface{}), 1, true)
+		})
+}
+
 func TestAccUltradnsDirpool(t *testing.T) {
 	var record udnssdk.RRSet
 	domain, _ := os.LookupEnv("ULTRADNS_DOMAIN")
@@ -1441,7 +1563,7 @@ func TestAccUltradnsDirpool(t *testing.T) {
 }
 
 func testAccDirpoolCheckDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*udnssdk.Client)
+	client := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ultradns_dirpool" {
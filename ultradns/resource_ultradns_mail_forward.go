@@ -0,0 +1,204 @@
+package ultradns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceUltradnsMailForward() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUltradnsMailForwardCreate,
+		ReadContext:   resourceUltradnsMailForwardRead,
+		UpdateContext: resourceUltradnsMailForwardUpdate,
+		DeleteContext: resourceUltradnsMailForwardDelete,
+
+		CustomizeDiff: customizeDiffProviderDefaults("zone"),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUltradnsMailForwardImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"from": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringIsNotWhiteSpace,
+					validation.StringDoesNotContainAny("@ "),
+				),
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^@\s]+@[^@\s]+$`), "must be an email address"),
+			},
+			// Computed
+			"guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// CRUD Operations
+
+func resourceUltradnsMailForwardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	zone := d.Get("zone").(string)
+	mf := makeMailForwardDTO(d)
+	log.Printf("[INFO] ultradns_mail_forward create: %s@%s", mf.ForwardFrom, zone)
+	guid, resp, err := client.createMailForward(zone, mf)
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	if guid == "" {
+		// Neither the response nor its Location named the new forward
+		guid, err = client.findMailForward(zone, mf.ForwardFrom)
+		if err != nil {
+			return diag.Errorf("create failed: %v", err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", zone, guid))
+	log.Printf("[INFO] ultradns_mail_forward.id: %v", d.Id())
+
+	return resourceUltradnsMailForwardRead(ctx, d, meta)
+}
+
+func resourceUltradnsMailForwardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	zone, guid, err := parseZoneGUIDId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] ultradns_mail_forward read: %s", d.Id())
+	mf, err := client.getMailForward(zone, guid)
+	if err != nil {
+		// 70002 means Data Not Found, 1801 that the zone is gone with it
+		if isNotFound(err) || isZoneNotFound(err) {
+			log.Printf("[WARN] ultradns_mail_forward %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("not found: %v", err)
+	}
+
+	d.Set("zone", zone)
+	d.Set("guid", guid)
+	d.Set("from", mf.ForwardFrom)
+	d.Set("to", mf.ForwardTo)
+
+	// Mail is delivered to the MX of the zone if it has one, so the
+	// forward would never be used.
+	hasMX, err := client.zoneHasMX(zone)
+	if err != nil {
+		return diag.Errorf("MX records of zone %s could not be checked: %v", zone, err)
+	}
+	if hasMX {
+		return diag.Diagnostics{{
+			Severity:      diag.Warning,
+			Summary:       "Zone has MX records",
+			Detail:        "Zone " + zone + " has MX records, which take precedence over mail forwarding. Mail to " + mf.ForwardFrom + "@" + strings.TrimSuffix(zone, ".") + " is not forwarded while they exist; remove the conflicting ultradns_record of type MX.",
+			AttributePath: cty.GetAttrPath("zone"),
+		}}
+	}
+	return nil
+}
+
+func resourceUltradnsMailForwardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	zone, guid, err := parseZoneGUIDId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mf := makeMailForwardDTO(d)
+	mf.GUID = guid
+	log.Printf("[INFO] ultradns_mail_forward update: %s", d.Id())
+	resp, err := client.updateMailForward(zone, guid, mf)
+	if err != nil {
+		return diag.Errorf("update failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("update failed: %v", err)
+	}
+
+	return resourceUltradnsMailForwardRead(ctx, d, meta)
+}
+
+func resourceUltradnsMailForwardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	zone, guid, err := parseZoneGUIDId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] ultradns_mail_forward delete: %s", d.Id())
+	resp, err := client.deleteMailForward(zone, guid)
+	if err != nil {
+		if isNotFound(err) || isZoneNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("delete failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("delete failed: %v", err)
+	}
+
+	return nil
+}
+
+// State function to seperate id into zone and guid
+func resourceUltradnsMailForwardImport(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	zone, guid, err := parseZoneGUIDId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("zone", zone)
+	d.Set("guid", guid)
+	return []*schema.ResourceData{d}, nil
+}
+
+// Resource Helpers
+
+func makeMailForwardDTO(d *schema.ResourceData) mailForwardDTO {
+	return mailForwardDTO{
+		ForwardFrom: d.Get("from").(string),
+		ForwardTo:   d.Get("to").(string),
+	}
+}
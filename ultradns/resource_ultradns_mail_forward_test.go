@@ -0,0 +1,109 @@
+package ultradns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	udnssdk "github.com/ultradns/ultradns-sdk-go"
+)
+
+func TestResourceUltradnsMailForwardImport(t *testing.T) {
+	d := resourceUltradnsMailForward().TestResourceData()
+	d.SetId("example.com:0608485259D5AC50")
+	imported, err := resourceUltradnsMailForwardImport(context.Background(), d, &Client{})
+	assert.Nil(t, err, true)
+	assert.Equal(t, "example.com", imported[0].Get("zone"), true)
+	assert.Equal(t, "0608485259D5AC50", imported[0].Get("guid"), true)
+
+	d.SetId("info@example.com")
+	_, err = resourceUltradnsMailForwardImport(context.Background(), d, &Client{})
+	assert.NotNil(t, err, true)
+}
+
+func TestResourceUltradnsMailForwardLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	testResourceLifecycle(t, fake, resourceUltradnsMailForward(),
+		map[string]interface{}{
+			"zone": "example.com",
+			"from": "info",
+			"to":   "brand@example.net",
+		},
+		map[string]interface{}{
+			"zone": "example.com",
+			"from": "sales",
+			"to":   "brand-sales@example.net",
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			z := fake.zones[fakeZoneName("example.com")]
+			assert.Len(t, z.mailForwards, 1, true)
+			mf := z.mailForwards[d.Get("guid").(string)]
+			assert.Equal(t, "sales", mf.ForwardFrom, true)
+			assert.Equal(t, "brand-sales@example.net", mf.ForwardTo, true)
+		})
+}
+
+func TestResourceUltradnsMailForwardAsyncWithoutLocation(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	fake.async = true
+	fake.noLocation = true
+	testResourceLifecycle(t, fake, resourceUltradnsMailForward(),
+		map[string]interface{}{
+			"zone": "example.com",
+			"from": "info",
+			"to":   "brand@example.net",
+		},
+		nil,
+		func(t *testing.T, d *schema.ResourceData) {
+			z := fake.zones[fakeZoneName("example.com")]
+			assert.Contains(t, z.mailForwards, d.Get("guid").(string), true)
+		})
+}
+
+func TestResourceUltradnsMailForwardCreateWithoutGUID(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	fake.async = true
+	fake.noLocation = true
+	fake.noCreatedBody = true
+	testResourceLifecycle(t, fake, resourceUltradnsMailForward(),
+		map[string]interface{}{
+			"zone": "example.com",
+			"from": "info",
+			"to":   "brand@example.net",
+		},
+		nil,
+		func(t *testing.T, d *schema.ResourceData) {
+			z := fake.zones[fakeZoneName("example.com")]
+			assert.Len(t, z.mailForwards, 1, true)
+			assert.Equal(t, "info", z.mailForwards[d.Get("guid").(string)].ForwardFrom, true)
+		})
+}
+
+func TestResourceUltradnsMailForwardMXWarning(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	client := fake.client(t)
+	ctx := context.Background()
+	res := resourceUltradnsMailForward()
+
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"zone": "example.com",
+		"from": "info",
+		"to":   "brand@example.net",
+	})
+	diags := res.CreateContext(ctx, d, client)
+	assert.Len(t, diags, 0, true)
+
+	// An MX record elsewhere in the zone does not conflict
+	fake.addRRSet("example.com", udnssdk.RRSet{OwnerName: "sub", RRType: "MX", TTL: 300, RData: []string{"10 mx.example.net."}})
+	diags = res.ReadContext(ctx, d, client)
+	assert.Len(t, diags, 0, true)
+
+	fake.addRRSet("example.com", udnssdk.RRSet{OwnerName: "example.com.", RRType: "MX", TTL: 300, RData: []string{"10 mx.example.net."}})
+	diags = res.ReadContext(ctx, d, client)
+	assert.Len(t, diags, 1, true)
+	assert.Equal(t, diag.Warning, diags[0].Severity, true)
+	assert.Contains(t, diags[0].Detail, "info@example.com", true)
+	assert.NotEqual(t, "", d.Id(), true)
+}
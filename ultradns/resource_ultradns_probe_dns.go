@@ -0,0 +1,337 @@
+package ultradns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ultradns/ultradns-sdk-go"
+)
+
+func resourceUltradnsProbeDNS() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUltradnsProbeDNSCreate,
+		ReadContext:   resourceUltradnsProbeDNSRead,
+		UpdateContext: resourceUltradnsProbeDNSUpdate,
+		DeleteContext: resourceUltradnsProbeDNSDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUltradnsProbeDNSImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Key
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pool_record": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			// Required
+			"agents": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"threshold": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			// Optional
+			"interval": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "FIVE_MINUTES",
+			},
+			"dns_probe": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     schemaDNSProbe(),
+			},
+			// Computed
+			"dns_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func schemaDNSProbe() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  53,
+			},
+			"tcp_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "NULL",
+			},
+			"query_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"response": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"limit": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      hashLimits,
+				Elem:     resourceProbeLimits(),
+			},
+		},
+	}
+}
+
+// dnsProbeDetailsDTO wraps DNS probe details. Unlike udnssdk's
+// DNSProbeDetailsDTO it has room for the response limit, which fails the
+// probe on a string rather than a number.
+type dnsProbeDetailsDTO struct {
+	Port       int                    `json:"port,omitempty"`
+	TCPOnly    bool                   `json:"tcpOnly,omitempty"`
+	RecordType string                 `json:"type,omitempty"`
+	OwnerName  string                 `json:"ownerName,omitempty"`
+	Limits     map[string]interface{} `json:"limits"`
+}
+
+// dnsProbeResponseLimit fails a DNS probe unless the response contains
+// Fail
+type dnsProbeResponseLimit struct {
+	Fail string `json:"fail"`
+}
+
+func resourceUltradnsProbeDNSCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := makeDNSProbeResource(d)
+	if err != nil {
+		return diag.Errorf("Could not load ultradns_probe_dns configuration: %v", err)
+	}
+
+	log.Printf("[INFO] ultradns_probe_dns create: %s:%s", r.Name, r.Zone)
+	resp, err := client.Probes.Create(r.Key().RRSetKey(), r.ProbeInfoDTO())
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	probeID, err := createdProbeID(client, resp, r)
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s:%s", d.Get("name"), d.Get("zone"), probeID))
+	log.Printf("[INFO] ultradns_probe_dns.dns_id: %v", d.Id())
+
+	return resourceUltradnsProbeDNSRead(ctx, d, meta)
+}
+
+func resourceUltradnsProbeDNSRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := makeDNSProbeResource(d)
+	if err != nil {
+		return diag.Errorf("Could not load ultradns_probe_dns configuration: %v", err)
+	}
+
+	log.Printf("[DEBUG] ultradns_probe_dns read: %s", d.Id())
+	probe, _, err := client.Probes.Find(r.Key())
+	if err != nil {
+		// 70002 means Probes Not Found
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("not found: %s", err)
+	}
+
+	d.Set("dns_id", r.ID)
+	if err := populateResourceDataFromDNSProbe(probe, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceUltradnsProbeDNSUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := makeDNSProbeResource(d)
+	if err != nil {
+		return diag.Errorf("Could not load ultradns_probe_dns configuration: %v", err)
+	}
+
+	log.Printf("[INFO] ultradns_probe_dns update: %s", d.Id())
+	resp, err := client.Probes.Update(r.Key(), r.ProbeInfoDTO())
+	if err != nil {
+		return diag.Errorf("update failed: %s", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("update failed: %s", err)
+	}
+
+	return resourceUltradnsProbeDNSRead(ctx, d, meta)
+}
+
+func resourceUltradnsProbeDNSDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := makeDNSProbeResource(d)
+	if err != nil {
+		return diag.Errorf("Could not load ultradns_probe_dns configuration: %s", err)
+	}
+
+	log.Printf("[INFO] ultradns_probe_dns delete: %s", d.Id())
+	resp, err := client.Probes.Delete(r.Key())
+	if err != nil {
+		return diag.Errorf("delete failed: %s", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("delete failed: %s", err)
+	}
+
+	return nil
+}
+
+// Resource Helpers
+
+func makeDNSProbeResource(d *schema.ResourceData) (probeResource, error) {
+	p := probeResource{}
+	p.Zone = d.Get("zone").(string)
+	p.Name = d.Get("name").(string)
+	p.ID = d.Id()
+	if len((strings.Split(string(d.Id()), ":"))) > 2 {
+		p.ID = (strings.Split(string(d.Id()), ":"))[2]
+	}
+
+	p.Interval = d.Get("interval").(string)
+	p.PoolRecord = d.Get("pool_record").(string)
+	p.Threshold = d.Get("threshold").(int)
+	for _, a := range d.Get("agents").([]interface{}) {
+		p.Agents = append(p.Agents, a.(string))
+	}
+
+	p.Type = udnssdk.DNSProbeType
+	dps := d.Get("dns_probe").([]interface{})
+	if len(dps) >= 1 {
+		if len(dps) > 1 {
+			return p, fmt.Errorf("dns_probe: only 0 or 1 blocks alowed, got: %#v", len(dps))
+		}
+		p.Details = makeDNSProbeDetails(dps[0])
+	}
+
+	return p, nil
+}
+
+func makeDNSProbeDetails(configured interface{}) *udnssdk.ProbeDetailsDTO {
+	data := configured.(map[string]interface{})
+	// Convert limits from flattened set format to mapping.
+	ls := make(map[string]interface{})
+	for _, limit := range data["limit"].(*schema.Set).List() {
+		l := limit.(map[string]interface{})
+		name := l["name"].(string)
+		ls[name] = *makeProbeDetailsLimit(l)
+	}
+	if response := data["response"].(string); response != "" {
+		ls["response"] = dnsProbeResponseLimit{Fail: response}
+	}
+	res := udnssdk.ProbeDetailsDTO{
+		Detail: dnsProbeDetailsDTO{
+			Port:       data["port"].(int),
+			TCPOnly:    data["tcp_only"].(bool),
+			RecordType: data["type"].(string),
+			OwnerName:  data["query_name"].(string),
+			Limits:     ls,
+		},
+	}
+	return &res
+}
+
+func populateResourceDataFromDNSProbe(p udnssdk.ProbeInfoDTO, d *schema.ResourceData) error {
+	d.Set("pool_record", p.PoolRecord)
+	d.Set("interval", p.Interval)
+	d.Set("agents", p.Agents)
+	d.Set("threshold", p.Threshold)
+
+	if p.Details == nil {
+		return nil
+	}
+	var pd struct {
+		dnsProbeDetailsDTO
+		Limits map[string]json.RawMessage `json:"limits"`
+	}
+	if err := json.Unmarshal(p.Details.GetData(), &pd); err != nil {
+		return fmt.Errorf("ProbeInfo.details could not be unmarshalled: %v, Details: %#v", err, p.Details)
+	}
+
+	var response string
+	ls := make(map[string]udnssdk.ProbeDetailsLimitDTO)
+	for name, raw := range pd.Limits {
+		var err error
+		if name == "response" {
+			var l dnsProbeResponseLimit
+			err = json.Unmarshal(raw, &l)
+			response = l.Fail
+		} else {
+			var l udnssdk.ProbeDetailsLimitDTO
+			err = json.Unmarshal(raw, &l)
+			ls[name] = l
+		}
+		if err != nil {
+			return fmt.Errorf("ProbeInfo.details limit %s could not be unmarshalled: %v", name, err)
+		}
+	}
+	dp := map[string]interface{}{
+		"port":       pd.Port,
+		"tcp_only":   pd.TCPOnly,
+		"type":       pd.RecordType,
+		"query_name": pd.OwnerName,
+		"response":   response,
+		"limit":      makeSetFromLimits(ls),
+	}
+
+	err := d.Set("dns_probe", []map[string]interface{}{dp})
+	if err != nil {
+		return fmt.Errorf("dns_probe set failed: %v, from %#v", err, dp)
+	}
+	return nil
+}
+
+// State function to seperate id into appropriate name and zone
+func resourceUltradnsProbeDNSImport(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return setProbeResourceAndParseId(d)
+}
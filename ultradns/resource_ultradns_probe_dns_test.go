@@ -0,0 +1,144 @@
+package ultradns
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	udnssdk "github.com/ultradns/ultradns-sdk-go"
+)
+
+func TestMakeDNSProbeDetails(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceUltradnsProbeDNS().Schema, map[string]interface{}{
+		"zone":      "example.com",
+		"name":      "pool",
+		"agents":    []interface{}{"DALLAS"},
+		"threshold": 1,
+		"dns_probe": []interface{}{
+			map[string]interface{}{
+				"port":       5353,
+				"tcp_only":   true,
+				"type":       "A",
+				"query_name": "www.example.com.",
+				"response":   "10.0.0.1",
+				"limit": []interface{}{
+					map[string]interface{}{"name": "run", "warning": 1, "critical": 2, "fail": 3},
+				},
+			},
+		},
+	})
+
+	p, err := makeDNSProbeResource(d)
+	assert.Nil(t, err, true)
+	assert.Equal(t, udnssdk.DNSProbeType, p.Type, true)
+
+	data, err := json.Marshal(p.Details)
+	assert.Nil(t, err, true)
+	assert.JSONEq(t, `{
+		"port": 5353,
+		"tcpOnly": true,
+		"type": "A",
+		"ownerName": "www.example.com.",
+		"limits": {
+			"run": {"warning": 1, "critical": 2, "fail": 3},
+			"response": {"fail": "10.0.0.1"}
+		}
+	}`, string(data), true)
+}
+
+func TestResourceUltradnsProbeDNSImport(t *testing.T) {
+	d := resourceUltradnsProbeDNS().TestResourceData()
+	d.SetId("pool:example.com:0608485259D5AC17")
+	imported, err := resourceUltradnsProbeDNSImport(context.Background(), d, &Client{})
+	assert.Nil(t, err, true)
+	assert.Equal(t, "pool", imported[0].Get("name"), true)
+	assert.Equal(t, "example.com", imported[0].Get("zone"), true)
+
+	d.SetId("pool.example.com")
+	_, err = resourceUltradnsProbeDNSImport(context.Background(), d, &Client{})
+	assert.NotNil(t, err, true)
+}
+
+func TestResourceUltradnsProbeDNSLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	fake.addRRSet("example.com", udnssdk.RRSet{OwnerName: "pool", RRType: "A", TTL: 300, RData: []string{"10.0.0.1"}})
+	testResourceLifecycle(t, fake, resourceUltradnsProbeDNS(),
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "pool",
+			"pool_record": "10.0.0.1",
+			"agents":      []interface{}{"DALLAS", "AMSTERDAM"},
+			"interval":    "ONE_MINUTE",
+			"threshold":   2,
+			"dns_probe": []interface{}{
+				map[string]interface{}{
+					"query_name": "www.example.com.",
+					"limit": []interface{}{
+						map[string]interface{}{"name": "run", "warning": 1, "critical": 2, "fail": 3},
+					},
+				},
+			},
+		},
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "pool",
+			"pool_record": "10.0.0.1",
+			"agents":      []interface{}{"DALLAS", "NEW_YORK"},
+			"interval":    "FIVE_MINUTES",
+			"threshold":   1,
+			"dns_probe": []interface{}{
+				map[string]interface{}{
+					"port":       5353,
+					"tcp_only":   true,
+					"type":       "A",
+					"query_name": "www.example.com.",
+					"response":   "10.0.0.1",
+					"limit": []interface{}{
+						map[string]interface{}{"name": "run", "warning": 2, "critical": 3, "fail": 4},
+						map[string]interface{}{"name": "avgRun", "warning": 1, "critical": 2, "fail": 3},
+					},
+				},
+			},
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			assert.Equal(t, strings.Split(d.Id(), ":")[2], d.Get("dns_id"), true)
+			assert.Equal(t, 5353, d.Get("dns_probe.0.port"), true)
+			assert.Equal(t, true, d.Get("dns_probe.0.tcp_only"), true)
+			assert.Equal(t, "A", d.Get("dns_probe.0.type"), true)
+			assert.Equal(t, "10.0.0.1", d.Get("dns_probe.0.response"), true)
+			assert.Equal(t, 2, d.Get("dns_probe.0.limit").(*schema.Set).Len(), true)
+		})
+}
+
+func TestResourceUltradnsProbeDNSCreateWithoutLocation(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	fake.addRRSet("example.com", udnssdk.RRSet{OwnerName: "pool", RRType: "A", TTL: 300, RData: []string{"10.0.0.1"}})
+	fake.async = true
+	fake.noLocation = true
+	client := fake.client(t)
+
+	res := resourceUltradnsProbeDNS()
+	config := map[string]interface{}{
+		"zone":        "example.com",
+		"name":        "pool",
+		"pool_record": "10.0.0.1",
+		"agents":      []interface{}{"DALLAS", "AMSTERDAM"},
+		"threshold":   2,
+	}
+	d := schema.TestResourceDataRaw(t, res.Schema, config)
+	assertNoDiagErrors(t, "create", res.CreateContext(context.Background(), d, client))
+	assert.Len(t, fake.probes, 1, true)
+	for id := range fake.probes {
+		assert.Equal(t, "pool:example.com:"+id, d.Id(), true)
+		assert.Equal(t, id, d.Get("dns_id"), true)
+	}
+
+	// A second probe like it cannot be told apart from the first
+	d = schema.TestResourceDataRaw(t, res.Schema, config)
+	diags := res.CreateContext(context.Background(), d, client)
+	assert.True(t, diags.HasError(), true)
+	assert.Contains(t, diags[0].Summary, "found 2 DNS probes", true)
+}
@@ -0,0 +1,302 @@
+package ultradns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ultradns/ultradns-sdk-go"
+)
+
+func resourceUltradnsProbeFTP() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUltradnsProbeFTPCreate,
+		ReadContext:   resourceUltradnsProbeFTPRead,
+		UpdateContext: resourceUltradnsProbeFTPUpdate,
+		DeleteContext: resourceUltradnsProbeFTPDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUltradnsProbeFTPImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Key
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pool_record": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			// Required
+			"agents": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"threshold": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			// Optional
+			"interval": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "FIVE_MINUTES",
+			},
+			"ftp_probe": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     schemaFTPProbe(),
+			},
+			// Computed
+			"ftp_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func schemaFTPProbe() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  21,
+			},
+			"passive_mode": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"limit": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      hashLimits,
+				Elem:     resourceProbeLimits(),
+			},
+		},
+	}
+}
+
+func resourceUltradnsProbeFTPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := makeFTPProbeResource(d)
+	if err != nil {
+		return diag.Errorf("Could not load ultradns_probe_ftp configuration: %v", err)
+	}
+
+	log.Printf("[INFO] ultradns_probe_ftp create: %s:%s", r.Name, r.Zone)
+	resp, err := client.Probes.Create(r.Key().RRSetKey(), r.ProbeInfoDTO())
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	probeID, err := createdProbeID(client, resp, r)
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s:%s", d.Get("name"), d.Get("zone"), probeID))
+	log.Printf("[INFO] ultradns_probe_ftp.ftp_id: %v", d.Id())
+
+	return resourceUltradnsProbeFTPRead(ctx, d, meta)
+}
+
+func resourceUltradnsProbeFTPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := makeFTPProbeResource(d)
+	if err != nil {
+		return diag.Errorf("Could not load ultradns_probe_ftp configuration: %v", err)
+	}
+
+	log.Printf("[DEBUG] ultradns_probe_ftp read: %s", d.Id())
+	probe, _, err := client.Probes.Find(r.Key())
+
+	if err != nil {
+		// 70002 means Probes Not Found
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("not found: %s", err)
+	}
+
+	d.Set("ftp_id", r.ID)
+	if err := populateResourceDataFromFTPProbe(probe, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceUltradnsProbeFTPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := makeFTPProbeResource(d)
+	if err != nil {
+		return diag.Errorf("Could not load ultradns_probe_ftp configuration: %v", err)
+	}
+
+	log.Printf("[INFO] ultradns_probe_ftp update: %s", d.Id())
+	resp, err := client.Probes.Update(r.Key(), r.ProbeInfoDTO())
+	if err != nil {
+		return diag.Errorf("update failed: %s", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("update failed: %s", err)
+	}
+
+	return resourceUltradnsProbeFTPRead(ctx, d, meta)
+}
+
+func resourceUltradnsProbeFTPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := makeFTPProbeResource(d)
+	if err != nil {
+		return diag.Errorf("Could not load ultradns_probe_ftp configuration: %s", err)
+	}
+
+	log.Printf("[INFO] ultradns_probe_ftp delete: %s", d.Id())
+	resp, err := client.Probes.Delete(r.Key())
+	if err != nil {
+		return diag.Errorf("delete failed: %s", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("delete failed: %s", err)
+	}
+
+	return nil
+}
+
+// Resource Helpers
+
+func makeFTPProbeResource(d *schema.ResourceData) (probeResource, error) {
+	p := probeResource{}
+	p.Zone = d.Get("zone").(string)
+	p.Name = d.Get("name").(string)
+	p.ID = d.Id()
+	if len((strings.Split(string(d.Id()), ":"))) > 2 {
+		p.ID = (strings.Split(string(d.Id()), ":"))[2]
+	}
+
+	p.Interval = d.Get("interval").(string)
+	p.PoolRecord = d.Get("pool_record").(string)
+	p.Threshold = d.Get("threshold").(int)
+	for _, a := range d.Get("agents").([]interface{}) {
+		p.Agents = append(p.Agents, a.(string))
+	}
+
+	p.Type = udnssdk.FTPProbeType
+	fps := d.Get("ftp_probe").([]interface{})
+	if len(fps) >= 1 {
+		if len(fps) > 1 {
+			return p, fmt.Errorf("ftp_probe: only 0 or 1 blocks alowed, got: %#v", len(fps))
+		}
+		p.Details = makeFTPProbeDetails(fps[0])
+	}
+
+	return p, nil
+}
+
+func makeFTPProbeDetails(configured interface{}) *udnssdk.ProbeDetailsDTO {
+	data := configured.(map[string]interface{})
+	// Convert limits from flattened set format to mapping.
+	ls := make(map[string]udnssdk.ProbeDetailsLimitDTO)
+	for _, limit := range data["limit"].(*schema.Set).List() {
+		l := limit.(map[string]interface{})
+		name := l["name"].(string)
+		ls[name] = *makeProbeDetailsLimit(l)
+	}
+	res := udnssdk.ProbeDetailsDTO{
+		Detail: udnssdk.FTPProbeDetailsDTO{
+			Limits:      ls,
+			Port:        data["port"].(int),
+			PassiveMode: data["passive_mode"].(bool),
+			Username:    data["username"].(string),
+			Password:    data["password"].(string),
+			Path:        data["path"].(string),
+		},
+	}
+	return &res
+}
+
+func populateResourceDataFromFTPProbe(p udnssdk.ProbeInfoDTO, d *schema.ResourceData) error {
+	d.Set("pool_record", p.PoolRecord)
+	d.Set("interval", p.Interval)
+	d.Set("agents", p.Agents)
+	d.Set("threshold", p.Threshold)
+
+	if p.Details == nil {
+		return nil
+	}
+	pd, err := p.Details.FTPProbeDetails()
+	if err != nil {
+		return fmt.Errorf("ProbeInfo.details could not be unmarshalled: %v, Details: %#v", err, p.Details)
+	}
+	// The API may not return the password; keep the configured one then.
+	password := pd.Password
+	if password == "" {
+		password = d.Get("ftp_probe.0.password").(string)
+	}
+	fp := map[string]interface{}{
+		"port":         pd.Port,
+		"passive_mode": pd.PassiveMode,
+		"username":     pd.Username,
+		"password":     password,
+		"path":         pd.Path,
+		"limit":        makeSetFromLimits(pd.Limits),
+	}
+
+	err = d.Set("ftp_probe", []map[string]interface{}{fp})
+	if err != nil {
+		return fmt.Errorf("ftp_probe set failed: %v", err)
+	}
+	return nil
+}
+
+// State function to seperate id into appropriate name and zone
+func resourceUltradnsProbeFTPImport(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return setProbeResourceAndParseId(d)
+}
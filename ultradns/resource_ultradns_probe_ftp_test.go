@@ -0,0 +1,112 @@
+package ultradns
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	udnssdk "github.com/ultradns/ultradns-sdk-go"
+)
+
+func TestMakeFTPProbeDetails(t *testing.T) {
+	details := makeFTPProbeDetails(map[string]interface{}{
+		"port":         21,
+		"passive_mode": true,
+		"username":     "probe",
+		"password":     "hunter2",
+		"path":         "/status.txt",
+		"limit": schema.NewSet(hashLimits, []interface{}{
+			map[string]interface{}{"name": "connect", "warning": 20, "critical": 25, "fail": 30},
+		}),
+	})
+	assert.Equal(t, udnssdk.FTPProbeDetailsDTO{
+		Port:        21,
+		PassiveMode: true,
+		Username:    "probe",
+		Password:    "hunter2",
+		Path:        "/status.txt",
+		Limits: map[string]udnssdk.ProbeDetailsLimitDTO{
+			"connect": {Warning: 20, Critical: 25, Fail: 30},
+		},
+	}, details.Detail, true)
+}
+
+func TestPopulateResourceDataFromFTPProbeKeepsPassword(t *testing.T) {
+	d := resourceUltradnsProbeFTP().TestResourceData()
+	d.Set("ftp_probe", []interface{}{
+		map[string]interface{}{"username": "probe", "password": "hunter2"},
+	})
+	var p udnssdk.ProbeInfoDTO
+	err := json.Unmarshal([]byte(`{"details": {"port": 21, "username": "probe", "limits": {}}}`), &p)
+	assert.Nil(t, err, true)
+	err = populateResourceDataFromFTPProbe(p, d)
+	assert.Nil(t, err, true)
+	assert.Equal(t, "hunter2", d.Get("ftp_probe.0.password"), true)
+}
+
+func TestResourceUltradnsProbeFTPImport(t *testing.T) {
+	d := resourceUltradnsProbeFTP().TestResourceData()
+	d.SetId("pool:example.com:0608485259D5AC17")
+	imported, err := resourceUltradnsProbeFTPImport(context.Background(), d, &Client{})
+	assert.Nil(t, err, true)
+	assert.Equal(t, "pool", imported[0].Get("name"), true)
+	assert.Equal(t, "example.com", imported[0].Get("zone"), true)
+
+	d.SetId("pool.example.com")
+	_, err = resourceUltradnsProbeFTPImport(context.Background(), d, &Client{})
+	assert.NotNil(t, err, true)
+}
+
+func TestResourceUltradnsProbeFTPLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	fake.addRRSet("example.com", udnssdk.RRSet{OwnerName: "ftp", RRType: "A", TTL: 300, RData: []string{"10.0.0.1"}})
+	testResourceLifecycle(t, fake, resourceUltradnsProbeFTP(),
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "ftp",
+			"pool_record": "10.0.0.1",
+			"agents":      []interface{}{"DALLAS", "AMSTERDAM"},
+			"interval":    "ONE_MINUTE",
+			"threshold":   2,
+			"ftp_probe": []interface{}{
+				map[string]interface{}{
+					"limit": []interface{}{
+						map[string]interface{}{"name": "connect", "warning": 20, "critical": 25, "fail": 30},
+					},
+				},
+			},
+		},
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "ftp",
+			"pool_record": "10.0.0.1",
+			"agents":      []interface{}{"DALLAS", "NEW_YORK"},
+			"interval":    "FIVE_MINUTES",
+			"threshold":   1,
+			"ftp_probe": []interface{}{
+				map[string]interface{}{
+					"port":         2121,
+					"passive_mode": true,
+					"username":     "probe",
+					"password":     "hunter2",
+					"path":         "/status.txt",
+					"limit": []interface{}{
+						map[string]interface{}{"name": "connect", "warning": 20, "critical": 25, "fail": 30},
+						map[string]interface{}{"name": "run", "warning": 10, "critical": 15, "fail": 20},
+					},
+				},
+			},
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			assert.Equal(t, strings.Split(d.Id(), ":")[2], d.Get("ftp_id"), true)
+			assert.Equal(t, 2121, d.Get("ftp_probe.0.port"), true)
+			assert.Equal(t, true, d.Get("ftp_probe.0.passive_mode"), true)
+			assert.Equal(t, "probe", d.Get("ftp_probe.0.username"), true)
+			assert.Equal(t, "hunter2", d.Get("ftp_probe.0.password"), true)
+			assert.Equal(t, "/status.txt", d.Get("ftp_probe.0.path"), true)
+			assert.Equal(t, 2, d.Get("ftp_probe.0.limit").(*schema.Set).Len(), true)
+		})
+}
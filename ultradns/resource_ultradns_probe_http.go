@@ -1,22 +1,30 @@
 package ultradns
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strings"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ultradns/ultradns-sdk-go"
 )
 
 func resourceUltradnsProbeHTTP() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceUltradnsProbeHTTPCreate,
-		Read:   resourceUltradnsProbeHTTPRead,
-		Update: resourceUltradnsProbeHTTPUpdate,
-		Delete: resourceUltradnsProbeHTTPDelete,
+		CreateContext: resourceUltradnsProbeHTTPCreate,
+		ReadContext:   resourceUltradnsProbeHTTPRead,
+		UpdateContext: resourceUltradnsProbeHTTPUpdate,
+		DeleteContext: resourceUltradnsProbeHTTPDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: resourceUltradnsProbeHTTPImport,
+			StateContext: resourceUltradnsProbeHTTPImport,
 		},
 		Schema: map[string]*schema.Schema{
 			// Key
@@ -124,40 +132,43 @@ func schemaHTTPProbe() *schema.Resource {
 	}
 }
 
-func resourceUltradnsProbeHTTPCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltradnsProbeHTTPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := makeHTTPProbeResource(d)
 	if err != nil {
-		return fmt.Errorf("Could not load ultradns_probe_http configuration: %v", err)
+		return diag.Errorf("Could not load ultradns_probe_http configuration: %v", err)
 	}
 
-	log.Printf("[INFO] ultradns_probe_http create: %#v, detail: %#v", r, r.Details.Detail)
+	log.Printf("[INFO] ultradns_probe_http create: %s:%s", r.Name, r.Zone)
 	resp, err := client.Probes.Create(r.Key().RRSetKey(), r.ProbeInfoDTO())
 	if err != nil {
-		return fmt.Errorf("create failed: %v", err)
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
 	}
 
 	uri := resp.Header.Get("Location")
 	d.Set("uri", uri)
 	id := fmt.Sprintf("%s:%s:%s", d.Get("name"), d.Get("zone"), strings.Split(uri, "probes/")[1])
 	d.SetId(id)
-	log.Infof("[INFO] ultradns_probe_http.http_id: %v", d.Id())
+	log.Printf("[INFO] ultradns_probe_http.http_id: %v", d.Id())
 
-	return resourceUltradnsProbeHTTPRead(d, meta)
+	return resourceUltradnsProbeHTTPRead(ctx, d, meta)
 }
 
-func resourceUltradnsProbeHTTPRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltradnsProbeHTTPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := makeHTTPProbeResource(d)
 	if err != nil {
-		return fmt.Errorf("Could not load ultradns_probe_http configuration: %v", err)
+		return diag.Errorf("Could not load ultradns_probe_http configuration: %v", err)
 	}
 
-	log.Printf("[DEBUG] ultradns_probe_http read: %#v", r)
+	log.Printf("[DEBUG] ultradns_probe_http read: %s", d.Id())
 	probe, _, err := client.Probes.Find(r.Key())
-	log.Printf("[DEBUG] ultradns_probe_http response: %#v", probe)
 
 	if err != nil {
 		uderr, ok := err.(*udnssdk.ErrorResponseList)
@@ -168,44 +179,55 @@ func resourceUltradnsProbeHTTPRead(d *schema.ResourceData, meta interface{}) err
 					d.SetId("")
 					return nil
 				}
-				return fmt.Errorf("not found: %s", err)
+				return diag.Errorf("not found: %s", err)
 			}
 		}
-		return fmt.Errorf("not found: %s", err)
+		return diag.Errorf("not found: %s", err)
 	}
 
-	return populateResourceDataFromHTTPProbe(probe, d)
+	if err := populateResourceDataFromHTTPProbe(probe, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-func resourceUltradnsProbeHTTPUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltradnsProbeHTTPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := makeHTTPProbeResource(d)
 	if err != nil {
-		return fmt.Errorf("Could not load ultradns_probe_http configuration: %v", err)
+		return diag.Errorf("Could not load ultradns_probe_http configuration: %v", err)
 	}
 
-	log.Printf("[INFO] ultradns_probe_http update: %+v", r)
-	_, err = client.Probes.Update(r.Key(), r.ProbeInfoDTO())
+	log.Printf("[INFO] ultradns_probe_http update: %s", d.Id())
+	resp, err := client.Probes.Update(r.Key(), r.ProbeInfoDTO())
 	if err != nil {
-		return fmt.Errorf("update failed: %s", err)
+		return diag.Errorf("update failed: %s", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("update failed: %s", err)
 	}
 
-	return resourceUltradnsProbeHTTPRead(d, meta)
+	return resourceUltradnsProbeHTTPRead(ctx, d, meta)
 }
 
-func resourceUltradnsProbeHTTPDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltradnsProbeHTTPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := makeHTTPProbeResource(d)
 	if err != nil {
-		return fmt.Errorf("Could not load ultradns_probe_http configuration: %s", err)
+		return diag.Errorf("Could not load ultradns_probe_http configuration: %s", err)
 	}
 
-	log.Printf("[INFO] ultradns_probe_http delete: %+v", r)
-	_, err = client.Probes.Delete(r.Key())
+	log.Printf("[INFO] ultradns_probe_http delete: %s", d.Id())
+	resp, err := client.Probes.Delete(r.Key())
+	if err != nil {
+		return diag.Errorf("delete failed: %s", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return fmt.Errorf("delete failed: %s", err)
+		return diag.Errorf("delete failed: %s", err)
 	}
 
 	return nil
@@ -326,6 +348,6 @@ func populateResourceDataFromHTTPProbe(p udnssdk.ProbeInfoDTO, d *schema.Resourc
 //  State importer function resourceUltradnsProbeHTTPImport
 // State Function to seperate id into appropriate name and zone
 func resourceUltradnsProbeHTTPImport(
-	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	return setProbeResourceAndParseId(d)
 }
@@ -1,13 +1,14 @@
 package ultradns
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	udnssdk "github.com/ultradns/ultradns-sdk-go"
@@ -332,7 +333,7 @@ func TestRresourceUltradnsProbePingImport(t *testing.T) {
 	resourceRecordObj := setResourceRecordHTTPProbe()
 	d := resourceRecordObj.TestResourceData()
 	d.SetId("test:test.provider.ultradns.net:0608485259D5AC79")
-	newRecordData, _ := resourceUltradnsProbeHTTPImport(d, udnssdk.Client{})
+	newRecordData, _ := resourceUltradnsProbeHTTPImport(context.Background(), d, udnssdk.Client{})
 	assert.Equal(t, newRecordData[0].Get("name"), "test", true)
 	assert.Equal(t, newRecordData[0].Get("zone"), "test.provider.ultradns.net", true)
 }
@@ -341,18 +342,73 @@ func TestResourceUltradnsProbeHTTPImportFailCase(t *testing.T) {
 	resourceRecordObj := setResourceRecordPingProbe()
 	d := resourceRecordObj.TestResourceData()
 	d.SetId("test.test.provider.ultradns.net.0608485259D5AC79")
-	_, err := resourceUltradnsProbeHTTPImport(d, udnssdk.Client{})
+	_, err := resourceUltradnsProbeHTTPImport(context.Background(), d, udnssdk.Client{})
 	log.Errorf("ERROR: %+v", err)
 	assert.NotNil(t, err, true)
 
 	// Case1 when only one delimiter are there
 	d.SetId("test:test.provider.ultradns.net.0608485259D5AC79")
-	_, err = resourceUltradnsProbeHTTPImport(d, udnssdk.Client{})
+	_, err = resourceUltradnsProbeHTTPImport(context.Background(), d, udnssdk.Client{})
 	log.Errorf("ERROR: %+v", err)
 	assert.NotNil(t, err, true)
 
 }
 
+func TestResourceUltradnsProbeHTTPLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	fake.addRRSet("example.com", udnssdk.RRSet{OwnerName: "pool", RRType: "A", TTL: 300, RData: []string{"10.0.0.1"}})
+	testResourceLifecycle(t, fake, resourceUltradnsProbeHTTP(),
+		map[string]interface{}{
+			"zone":      "example.com",
+			"name":      "pool",
+			"agents":    []interface{}{"DALLAS", "AMSTERDAM"},
+			"interval":  "ONE_MINUTE",
+			"threshold": 2,
+			"http_probe": []interface{}{
+				map[string]interface{}{
+					"transaction": []interface{}{
+						map[string]interface{}{
+							"method": "GET",
+							"url":    "http://localhost/index",
+							"limit": []interface{}{
+								map[string]interface{}{"name": "run", "warning": 1, "critical": 2, "fail": 3},
+							},
+						},
+					},
+				},
+			},
+		},
+		map[string]interface{}{
+			"zone":      "example.com",
+			"name":      "pool",
+			"agents":    []interface{}{"DALLAS", "AMSTERDAM", "NEW_YORK"},
+			"interval":  "FIVE_MINUTES",
+			"threshold": 3,
+			"http_probe": []interface{}{
+				map[string]interface{}{
+					"transaction": []interface{}{
+						map[string]interface{}{
+							"method":           "POST",
+							"url":              "http://localhost/health",
+							"transmitted_data": "ping",
+							"follow_redirects": true,
+							"limit": []interface{}{
+								map[string]interface{}{"name": "run", "warning": 2, "critical": 3, "fail": 4},
+							},
+						},
+					},
+					"total_limits": []interface{}{
+						map[string]interface{}{"warning": 5, "critical": 6, "fail": 7},
+					},
+				},
+			},
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			assert.Equal(t, "POST", d.Get("http_probe.0.transaction.0.method"), true)
+			assert.Equal(t, 7, d.Get("http_probe.0.total_limits.0.fail"), true)
+		})
+}
+
 func TestAccUltradnsProbeHTTP(t *testing.T) {
 	var record udnssdk.RRSet
 	domain, _ := os.LookupEnv("ULTRADNS_DOMAIN")
@@ -1,23 +1,32 @@
 package ultradns
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strings"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ultradns/ultradns-sdk-go"
 )
 
 func resourceUltradnsProbePing() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceUltradnsProbePingCreate,
-		Read:   resourceUltradnsProbePingRead,
-		Update: resourceUltradnsProbePingUpdate,
-		Delete: resourceUltradnsProbePingDelete,
+		CreateContext: resourceUltradnsProbePingCreate,
+		ReadContext:   resourceUltradnsProbePingRead,
+		UpdateContext: resourceUltradnsProbePingUpdate,
+		DeleteContext: resourceUltradnsProbePingDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Importer: &schema.ResourceImporter{
-			State: resourceUltradnsProbePingImport,
+			StateContext: resourceUltradnsProbePingImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,18 +76,22 @@ func resourceUltradnsProbePing() *schema.Resource {
 	}
 }
 
-func resourceUltradnsProbePingCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltradnsProbePingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := makePingProbeResource(d)
 	if err != nil {
-		return fmt.Errorf("Could not load ultradns_probe_ping configuration: %v", err)
+		return diag.Errorf("Could not load ultradns_probe_ping configuration: %v", err)
 	}
 
-	log.Printf("[INFO] ultradns_probe_ping create: %#v, detail: %#v", r, r.Details.Detail)
+	log.Printf("[INFO] ultradns_probe_ping create: %s:%s", r.Name, r.Zone)
 	resp, err := client.Probes.Create(r.Key().RRSetKey(), r.ProbeInfoDTO())
 	if err != nil {
-		return fmt.Errorf("create failed: %v", err)
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
 	}
 
 	uri := resp.Header.Get("Location")
@@ -87,20 +100,19 @@ func resourceUltradnsProbePingCreate(d *schema.ResourceData, meta interface{}) e
 	d.SetId(id)
 	log.Printf("[INFO] ultradns_probe_ping.ping_id: %v", d.Id())
 
-	return resourceUltradnsProbePingRead(d, meta)
+	return resourceUltradnsProbePingRead(ctx, d, meta)
 }
 
-func resourceUltradnsProbePingRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltradnsProbePingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := makePingProbeResource(d)
 	if err != nil {
-		return fmt.Errorf("Could not load ultradns_probe_ping configuration: %v", err)
+		return diag.Errorf("Could not load ultradns_probe_ping configuration: %v", err)
 	}
 
-	log.Printf("[DEBUG] ultradns_probe_ping read: %#v", r)
+	log.Printf("[DEBUG] ultradns_probe_ping read: %s", d.Id())
 	probe, _, err := client.Probes.Find(r.Key())
-	log.Printf("[DEBUG] ultradns_probe_ping response: %#v", probe)
 
 	if err != nil {
 		uderr, ok := err.(*udnssdk.ErrorResponseList)
@@ -111,44 +123,55 @@ func resourceUltradnsProbePingRead(d *schema.ResourceData, meta interface{}) err
 					d.SetId("")
 					return nil
 				}
-				return fmt.Errorf("not found: %s", err)
+				return diag.Errorf("not found: %s", err)
 			}
 		}
-		return fmt.Errorf("not found: %s", err)
+		return diag.Errorf("not found: %s", err)
 	}
 
-	return populateResourceDataFromPingProbe(probe, d)
+	if err := populateResourceDataFromPingProbe(probe, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-func resourceUltradnsProbePingUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltradnsProbePingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := makePingProbeResource(d)
 	if err != nil {
-		return fmt.Errorf("Could not load ultradns_probe_ping configuration: %v", err)
+		return diag.Errorf("Could not load ultradns_probe_ping configuration: %v", err)
 	}
 
-	log.Printf("[INFO] ultradns_probe_ping update: %+v", r)
-	_, err = client.Probes.Update(r.Key(), r.ProbeInfoDTO())
+	log.Printf("[INFO] ultradns_probe_ping update: %s", d.Id())
+	resp, err := client.Probes.Update(r.Key(), r.ProbeInfoDTO())
 	if err != nil {
-		return fmt.Errorf("update failed: %s", err)
+		return diag.Errorf("update failed: %s", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("update failed: %s", err)
 	}
 
-	return resourceUltradnsProbePingRead(d, meta)
+	return resourceUltradnsProbePingRead(ctx, d, meta)
 }
 
-func resourceUltradnsProbePingDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltradnsProbePingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := makePingProbeResource(d)
 	if err != nil {
-		return fmt.Errorf("Could not load ultradns_probe_ping configuration: %s", err)
+		return diag.Errorf("Could not load ultradns_probe_ping configuration: %s", err)
 	}
 
-	log.Printf("[INFO] ultradns_probe_ping delete: %+v", r)
-	_, err = client.Probes.Delete(r.Key())
+	log.Printf("[INFO] ultradns_probe_ping delete: %s", d.Id())
+	resp, err := client.Probes.Delete(r.Key())
+	if err != nil {
+		return diag.Errorf("delete failed: %s", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return fmt.Errorf("delete failed: %s", err)
+		return diag.Errorf("delete failed: %s", err)
 	}
 
 	return nil
@@ -179,7 +202,6 @@ func makePingProbeResource(d *schema.ResourceData) (probeResource, error) {
 			return p, fmt.Errorf("ping_probe: only 0 or 1 blocks alowed, got: %#v", len(pps))
 		}
 		p.Details = makePingProbeDetails(pps[0])
-		log.Infof("%+v p.Details", p.Details.Detail)
 	}
 
 	return p, nil
@@ -188,14 +210,11 @@ func makePingProbeResource(d *schema.ResourceData) (probeResource, error) {
 func makePingProbeDetails(configured interface{}) *udnssdk.ProbeDetailsDTO {
 	data := configured.(map[string]interface{})
 	// Convert limits from flattened set format to mapping.
-	log.Infof("%+v limit", data["limit"].(*schema.Set).List())
 	ls := make(map[string]udnssdk.ProbeDetailsLimitDTO)
 	for _, limit := range data["limit"].(*schema.Set).List() {
 		l := limit.(map[string]interface{})
-		log.Infof("%+v limit1", l)
 		name := l["name"].(string)
 		ls[name] = *makeProbeDetailsLimit(l)
-		log.Infof("%+v ls:", ls)
 	}
 	res := udnssdk.ProbeDetailsDTO{
 		Detail: udnssdk.PingProbeDetailsDTO{
@@ -204,7 +223,6 @@ func makePingProbeDetails(configured interface{}) *udnssdk.ProbeDetailsDTO {
 			Packets:    data["packets"].(int),
 		},
 	}
-	log.Infof("%+v res:", res)
 	return &res
 }
 
@@ -216,8 +234,6 @@ func populateResourceDataFromPingProbe(p udnssdk.ProbeInfoDTO, d *schema.Resourc
 	d.Set("threshold", p.Threshold)
 
 	pd, err := p.Details.PingProbeDetails()
-	log.Infof("%#v Details", p)
-	log.Infof("%+v limits", pd.Limits)
 	if err != nil {
 		return fmt.Errorf("ProbeInfo.details could not be unmarshalled: %v, Details: %#v", err, p.Details)
 	}
@@ -226,7 +242,6 @@ func populateResourceDataFromPingProbe(p udnssdk.ProbeInfoDTO, d *schema.Resourc
 		"packet_size": pd.PacketSize,
 		"limit":       makeSetFromLimits(pd.Limits),
 	}
-	log.Infof("pp: %+v", pp)
 
 	err = d.Set("ping_probe", []map[string]interface{}{pp})
 	if err != nil {
@@ -237,6 +252,6 @@ func populateResourceDataFromPingProbe(p udnssdk.ProbeInfoDTO, d *schema.Resourc
 
 // State function to seperate id into appropriate name and zone
 func resourceUltradnsProbePingImport(
-	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	return setProbeResourceAndParseId(d)
 }
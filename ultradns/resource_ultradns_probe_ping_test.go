@@ -1,14 +1,15 @@
 package ultradns
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	udnssdk "github.com/ultradns/ultradns-sdk-go"
@@ -312,7 +313,7 @@ func TestResourceUltradnsProbePingImport(t *testing.T) {
 	d := resourceRecordObj.TestResourceData()
 	d.SetId("test:test.provider.ultradns.net:0608485259D5AC79")
 	var interfaceEmpty *udnssdk.Client
-	newRecordData, _ := resourceUltradnsProbePingImport(d, interfaceEmpty)
+	newRecordData, _ := resourceUltradnsProbePingImport(context.Background(), d, interfaceEmpty)
 	assert.Equal(t, newRecordData[0].Get("name"), "test", true)
 	assert.Equal(t, newRecordData[0].Get("zone"), "test.provider.ultradns.net", true)
 }
@@ -322,17 +323,61 @@ func TestResourceUltradnsProbePingImportFailCase(t *testing.T) {
 	d := resourceRecordObj.TestResourceData()
 	d.SetId("test.test.provider.ultradns.net.0608485259D5AC79")
 	var interfaceEmpty *udnssdk.Client
-	_, err := resourceUltradnsProbePingImport(d, interfaceEmpty)
+	_, err := resourceUltradnsProbePingImport(context.Background(), d, interfaceEmpty)
 	log.Errorf("ERROR: %+v", err)
 	assert.NotNil(t, err, true)
 
 	// Case1 when only one delimiter are there
 	d.SetId("test:test.provider.ultradns.net.0608485259D5AC79")
-	_, err = resourceUltradnsProbeHTTPImport(d, udnssdk.Client{})
+	_, err = resourceUltradnsProbeHTTPImport(context.Background(), d, udnssdk.Client{})
 	log.Errorf("ERROR: %+v", err)
 	assert.NotNil(t, err, true)
 }
 
+func TestResourceUltradnsProbePingLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	fake.addRRSet("example.com", udnssdk.RRSet{OwnerName: "pool", RRType: "A", TTL: 300, RData: []string{"10.0.0.1"}})
+	testResourceLifecycle(t, fake, resourceUltradnsProbePing(),
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "pool",
+			"pool_record": "10.0.0.1",
+			"agents":      []interface{}{"DALLAS", "AMSTERDAM"},
+			"interval":    "ONE_MINUTE",
+			"threshold":   2,
+			"ping_probe": []interface{}{
+				map[string]interface{}{
+					"packets":     15,
+					"packet_size": 56,
+					"limit": []interface{}{
+						map[string]interface{}{"name": "lossPercent", "warning": 1, "critical": 2, "fail": 3},
+					},
+				},
+			},
+		},
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "pool",
+			"pool_record": "10.0.0.1",
+			"agents":      []interface{}{"DALLAS", "NEW_YORK"},
+			"interval":    "FIVE_MINUTES",
+			"threshold":   1,
+			"ping_probe": []interface{}{
+				map[string]interface{}{
+					"packets":     10,
+					"packet_size": 128,
+					"limit": []interface{}{
+						map[string]interface{}{"name": "lossPercent", "warning": 2, "critical": 3, "fail": 4},
+					},
+				},
+			},
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			assert.Equal(t, 128, d.Get("ping_probe.0.packet_size"), true)
+			assert.Equal(t, []interface{}{"DALLAS", "NEW_YORK"}, d.Get("agents"), true)
+		})
+}
+
 func TestAccUltradnsProbePing(t *testing.T) {
 	var record udnssdk.RRSet
 	domain, _ := os.LookupEnv("ULTRADNS_DOMAIN")
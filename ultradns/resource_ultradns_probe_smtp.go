@@ -0,0 +1,271 @@
+package ultradns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ultradns/ultradns-sdk-go"
+)
+
+func resourceUltradnsProbeSMTP() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUltradnsProbeSMTPCreate,
+		ReadContext:   resourceUltradnsProbeSMTPRead,
+		UpdateContext: resourceUltradnsProbeSMTPUpdate,
+		DeleteContext: resourceUltradnsProbeSMTPDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUltradnsProbeSMTPImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Key
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pool_record": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			// Required
+			"agents": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"threshold": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			// Optional
+			"interval": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "FIVE_MINUTES",
+			},
+			"smtp_probe": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     schemaSMTPProbe(),
+			},
+			// Computed
+			"smtp_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func schemaSMTPProbe() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  25,
+			},
+			"limit": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      hashLimits,
+				Elem:     resourceProbeLimits(),
+			},
+		},
+	}
+}
+
+func resourceUltradnsProbeSMTPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := makeSMTPProbeResource(d)
+	if err != nil {
+		return diag.Errorf("Could not load ultradns_probe_smtp configuration: %v", err)
+	}
+
+	log.Printf("[INFO] ultradns_probe_smtp create: %s:%s", r.Name, r.Zone)
+	resp, err := client.Probes.Create(r.Key().RRSetKey(), r.ProbeInfoDTO())
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	probeID, err := createdProbeID(client, resp, r)
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s:%s", d.Get("name"), d.Get("zone"), probeID))
+	log.Printf("[INFO] ultradns_probe_smtp.smtp_id: %v", d.Id())
+
+	return resourceUltradnsProbeSMTPRead(ctx, d, meta)
+}
+
+func resourceUltradnsProbeSMTPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := makeSMTPProbeResource(d)
+	if err != nil {
+		return diag.Errorf("Could not load ultradns_probe_smtp configuration: %v", err)
+	}
+
+	log.Printf("[DEBUG] ultradns_probe_smtp read: %s", d.Id())
+	probe, _, err := client.Probes.Find(r.Key())
+
+	if err != nil {
+		// 70002 means Probes Not Found
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("not found: %s", err)
+	}
+
+	d.Set("smtp_id", r.ID)
+	if err := populateResourceDataFromSMTPProbe(probe, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceUltradnsProbeSMTPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := makeSMTPProbeResource(d)
+	if err != nil {
+		return diag.Errorf("Could not load ultradns_probe_smtp configuration: %v", err)
+	}
+
+	log.Printf("[INFO] ultradns_probe_smtp update: %s", d.Id())
+	resp, err := client.Probes.Update(r.Key(), r.ProbeInfoDTO())
+	if err != nil {
+		return diag.Errorf("update failed: %s", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("update failed: %s", err)
+	}
+
+	return resourceUltradnsProbeSMTPRead(ctx, d, meta)
+}
+
+func resourceUltradnsProbeSMTPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := makeSMTPProbeResource(d)
+	if err != nil {
+		return diag.Errorf("Could not load ultradns_probe_smtp configuration: %s", err)
+	}
+
+	log.Printf("[INFO] ultradns_probe_smtp delete: %s", d.Id())
+	resp, err := client.Probes.Delete(r.Key())
+	if err != nil {
+		return diag.Errorf("delete failed: %s", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("delete failed: %s", err)
+	}
+
+	return nil
+}
+
+// Resource Helpers
+
+func makeSMTPProbeResource(d *schema.ResourceData) (probeResource, error) {
+	p := probeResource{}
+	p.Zone = d.Get("zone").(string)
+	p.Name = d.Get("name").(string)
+	p.ID = d.Id()
+	if len((strings.Split(string(d.Id()), ":"))) > 2 {
+		p.ID = (strings.Split(string(d.Id()), ":"))[2]
+	}
+
+	p.Interval = d.Get("interval").(string)
+	p.PoolRecord = d.Get("pool_record").(string)
+	p.Threshold = d.Get("threshold").(int)
+	for _, a := range d.Get("agents").([]interface{}) {
+		p.Agents = append(p.Agents, a.(string))
+	}
+
+	p.Type = udnssdk.SMTPProbeType
+	smtpps := d.Get("smtp_probe").([]interface{})
+	if len(smtpps) >= 1 {
+		if len(smtpps) > 1 {
+			return p, fmt.Errorf("smtp_probe: only 0 or 1 blocks alowed, got: %#v", len(smtpps))
+		}
+		p.Details = makeSMTPProbeDetails(smtpps[0])
+	}
+
+	return p, nil
+}
+
+func makeSMTPProbeDetails(configured interface{}) *udnssdk.ProbeDetailsDTO {
+	data := configured.(map[string]interface{})
+	// Convert limits from flattened set format to mapping.
+	ls := make(map[string]udnssdk.ProbeDetailsLimitDTO)
+	for _, limit := range data["limit"].(*schema.Set).List() {
+		l := limit.(map[string]interface{})
+		name := l["name"].(string)
+		ls[name] = *makeProbeDetailsLimit(l)
+	}
+	res := udnssdk.ProbeDetailsDTO{
+		Detail: udnssdk.SMTPProbeDetailsDTO{
+			Limits: ls,
+			Port:   data["port"].(int),
+		},
+	}
+	return &res
+}
+
+func populateResourceDataFromSMTPProbe(p udnssdk.ProbeInfoDTO, d *schema.ResourceData) error {
+	d.Set("pool_record", p.PoolRecord)
+	d.Set("interval", p.Interval)
+	d.Set("agents", p.Agents)
+	d.Set("threshold", p.Threshold)
+
+	if p.Details == nil {
+		return nil
+	}
+	pd, err := p.Details.SMTPProbeDetails()
+	if err != nil {
+		return fmt.Errorf("ProbeInfo.details could not be unmarshalled: %v, Details: %#v", err, p.Details)
+	}
+	sp := map[string]interface{}{
+		"port":  pd.Port,
+		"limit": makeSetFromLimits(pd.Limits),
+	}
+
+	err = d.Set("smtp_probe", []map[string]interface{}{sp})
+	if err != nil {
+		return fmt.Errorf("smtp_probe set failed: %v, from %#v", err, sp)
+	}
+	return nil
+}
+
+// State function to seperate id into appropriate name and zone
+func resourceUltradnsProbeSMTPImport(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return setProbeResourceAndParseId(d)
+}
@@ -0,0 +1,93 @@
+package ultradns
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	udnssdk "github.com/ultradns/ultradns-sdk-go"
+)
+
+func TestMakeSMTPSendProbeDetails(t *testing.T) {
+	details := makeSMTPSendProbeDetails(map[string]interface{}{
+		"port":    25,
+		"from":    "probe@example.com",
+		"to":      "postmaster@example.com",
+		"message": "probe",
+		"limit": schema.NewSet(hashLimits, []interface{}{
+			map[string]interface{}{"name": "run", "warning": 20, "critical": 25, "fail": 30},
+		}),
+	})
+	assert.Equal(t, udnssdk.SMTPSENDProbeDetailsDTO{
+		Port:    25,
+		From:    "probe@example.com",
+		To:      "postmaster@example.com",
+		Message: "probe",
+		Limits: map[string]udnssdk.ProbeDetailsLimitDTO{
+			"run": {Warning: 20, Critical: 25, Fail: 30},
+		},
+	}, details.Detail, true)
+}
+
+func TestResourceUltradnsProbeSMTPSendImport(t *testing.T) {
+	d := resourceUltradnsProbeSMTPSend().TestResourceData()
+	d.SetId("pool:example.com:0608485259D5AC17")
+	imported, err := resourceUltradnsProbeSMTPSendImport(context.Background(), d, &Client{})
+	assert.Nil(t, err, true)
+	assert.Equal(t, "pool", imported[0].Get("name"), true)
+	assert.Equal(t, "example.com", imported[0].Get("zone"), true)
+
+	d.SetId("pool.example.com")
+	_, err = resourceUltradnsProbeSMTPSendImport(context.Background(), d, &Client{})
+	assert.NotNil(t, err, true)
+}
+
+func TestResourceUltradnsProbeSMTPSendLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	fake.addRRSet("example.com", udnssdk.RRSet{OwnerName: "mx", RRType: "A", TTL: 300, RData: []string{"10.0.0.1"}})
+	testResourceLifecycle(t, fake, resourceUltradnsProbeSMTPSend(),
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "mx",
+			"pool_record": "10.0.0.1",
+			"agents":      []interface{}{"DALLAS", "AMSTERDAM"},
+			"interval":    "ONE_MINUTE",
+			"threshold":   2,
+			"smtp_send_probe": []interface{}{
+				map[string]interface{}{
+					"from": "probe@example.com",
+					"to":   "postmaster@example.com",
+					"limit": []interface{}{
+						map[string]interface{}{"name": "run", "warning": 20, "critical": 25, "fail": 30},
+					},
+				},
+			},
+		},
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "mx",
+			"pool_record": "10.0.0.1",
+			"agents":      []interface{}{"DALLAS", "NEW_YORK"},
+			"interval":    "FIVE_MINUTES",
+			"threshold":   1,
+			"smtp_send_probe": []interface{}{
+				map[string]interface{}{
+					"port":    587,
+					"from":    "probe@example.com",
+					"to":      "noc@example.com",
+					"message": "UltraDNS probe",
+					"limit": []interface{}{
+						map[string]interface{}{"name": "run", "warning": 20, "critical": 25, "fail": 30},
+					},
+				},
+			},
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			assert.Equal(t, strings.Split(d.Id(), ":")[2], d.Get("smtp_send_id"), true)
+			assert.Equal(t, 587, d.Get("smtp_send_probe.0.port"), true)
+			assert.Equal(t, "noc@example.com", d.Get("smtp_send_probe.0.to"), true)
+			assert.Equal(t, "UltraDNS probe", d.Get("smtp_send_probe.0.message"), true)
+		})
+}
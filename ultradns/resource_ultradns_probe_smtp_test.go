@@ -0,0 +1,82 @@
+package ultradns
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	udnssdk "github.com/ultradns/ultradns-sdk-go"
+)
+
+func TestMakeSMTPProbeDetails(t *testing.T) {
+	details := makeSMTPProbeDetails(map[string]interface{}{
+		"port": 587,
+		"limit": schema.NewSet(hashLimits, []interface{}{
+			map[string]interface{}{"name": "run", "warning": 20, "critical": 25, "fail": 30},
+		}),
+	})
+	assert.Equal(t, udnssdk.SMTPProbeDetailsDTO{
+		Port: 587,
+		Limits: map[string]udnssdk.ProbeDetailsLimitDTO{
+			"run": {Warning: 20, Critical: 25, Fail: 30},
+		},
+	}, details.Detail, true)
+}
+
+func TestResourceUltradnsProbeSMTPImport(t *testing.T) {
+	d := resourceUltradnsProbeSMTP().TestResourceData()
+	d.SetId("pool:example.com:0608485259D5AC17")
+	imported, err := resourceUltradnsProbeSMTPImport(context.Background(), d, &Client{})
+	assert.Nil(t, err, true)
+	assert.Equal(t, "pool", imported[0].Get("name"), true)
+	assert.Equal(t, "example.com", imported[0].Get("zone"), true)
+
+	d.SetId("pool.example.com")
+	_, err = resourceUltradnsProbeSMTPImport(context.Background(), d, &Client{})
+	assert.NotNil(t, err, true)
+}
+
+func TestResourceUltradnsProbeSMTPLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	fake.addRRSet("example.com", udnssdk.RRSet{OwnerName: "mx", RRType: "A", TTL: 300, RData: []string{"10.0.0.1"}})
+	testResourceLifecycle(t, fake, resourceUltradnsProbeSMTP(),
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "mx",
+			"pool_record": "10.0.0.1",
+			"agents":      []interface{}{"DALLAS", "AMSTERDAM"},
+			"interval":    "ONE_MINUTE",
+			"threshold":   2,
+			"smtp_probe": []interface{}{
+				map[string]interface{}{
+					"limit": []interface{}{
+						map[string]interface{}{"name": "run", "warning": 20, "critical": 25, "fail": 30},
+					},
+				},
+			},
+		},
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "mx",
+			"pool_record": "10.0.0.1",
+			"agents":      []interface{}{"DALLAS", "NEW_YORK"},
+			"interval":    "FIVE_MINUTES",
+			"threshold":   1,
+			"smtp_probe": []interface{}{
+				map[string]interface{}{
+					"port": 587,
+					"limit": []interface{}{
+						map[string]interface{}{"name": "run", "warning": 20, "critical": 25, "fail": 30},
+						map[string]interface{}{"name": "connect", "warning": 10, "critical": 15, "fail": 20},
+					},
+				},
+			},
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			assert.Equal(t, strings.Split(d.Id(), ":")[2], d.Get("smtp_id"), true)
+			assert.Equal(t, 587, d.Get("smtp_probe.0.port"), true)
+			assert.Equal(t, 2, d.Get("smtp_probe.0.limit").(*schema.Set).Len(), true)
+		})
+}
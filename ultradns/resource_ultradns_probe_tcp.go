@@ -0,0 +1,276 @@
+package ultradns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ultradns/ultradns-sdk-go"
+)
+
+func resourceUltradnsProbeTCP() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUltradnsProbeTCPCreate,
+		ReadContext:   resourceUltradnsProbeTCPRead,
+		UpdateContext: resourceUltradnsProbeTCPUpdate,
+		DeleteContext: resourceUltradnsProbeTCPDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUltradnsProbeTCPImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Key
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pool_record": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			// Required
+			"agents": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"threshold": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			// Optional
+			"interval": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "FIVE_MINUTES",
+			},
+			"tcp_probe": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     schemaTCPProbe(),
+			},
+			// Computed
+			"tcp_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func schemaTCPProbe() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"port": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"control_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"limit": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      hashLimits,
+				Elem:     resourceProbeLimits(),
+			},
+		},
+	}
+}
+
+func resourceUltradnsProbeTCPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := makeTCPProbeResource(d)
+	if err != nil {
+		return diag.Errorf("Could not load ultradns_probe_tcp configuration: %v", err)
+	}
+
+	log.Printf("[INFO] ultradns_probe_tcp create: %s:%s", r.Name, r.Zone)
+	resp, err := client.Probes.Create(r.Key().RRSetKey(), r.ProbeInfoDTO())
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	probeID, err := createdProbeID(client, resp, r)
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s:%s", d.Get("name"), d.Get("zone"), probeID))
+	log.Printf("[INFO] ultradns_probe_tcp.tcp_id: %v", d.Id())
+
+	return resourceUltradnsProbeTCPRead(ctx, d, meta)
+}
+
+func resourceUltradnsProbeTCPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := makeTCPProbeResource(d)
+	if err != nil {
+		return diag.Errorf("Could not load ultradns_probe_tcp configuration: %v", err)
+	}
+
+	log.Printf("[DEBUG] ultradns_probe_tcp read: %s", d.Id())
+	probe, _, err := client.Probes.Find(r.Key())
+
+	if err != nil {
+		// 70002 means Probes Not Found
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("not found: %s", err)
+	}
+
+	d.Set("tcp_id", r.ID)
+	if err := populateResourceDataFromTCPProbe(probe, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceUltradnsProbeTCPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := makeTCPProbeResource(d)
+	if err != nil {
+		return diag.Errorf("Could not load ultradns_probe_tcp configuration: %v", err)
+	}
+
+	log.Printf("[INFO] ultradns_probe_tcp update: %s", d.Id())
+	resp, err := client.Probes.Update(r.Key(), r.ProbeInfoDTO())
+	if err != nil {
+		return diag.Errorf("update failed: %s", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("update failed: %s", err)
+	}
+
+	return resourceUltradnsProbeTCPRead(ctx, d, meta)
+}
+
+func resourceUltradnsProbeTCPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := makeTCPProbeResource(d)
+	if err != nil {
+		return diag.Errorf("Could not load ultradns_probe_tcp configuration: %s", err)
+	}
+
+	log.Printf("[INFO] ultradns_probe_tcp delete: %s", d.Id())
+	resp, err := client.Probes.Delete(r.Key())
+	if err != nil {
+		return diag.Errorf("delete failed: %s", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("delete failed: %s", err)
+	}
+
+	return nil
+}
+
+// Resource Helpers
+
+func makeTCPProbeResource(d *schema.ResourceData) (probeResource, error) {
+	p := probeResource{}
+	p.Zone = d.Get("zone").(string)
+	p.Name = d.Get("name").(string)
+	p.ID = d.Id()
+	if len((strings.Split(string(d.Id()), ":"))) > 2 {
+		p.ID = (strings.Split(string(d.Id()), ":"))[2]
+	}
+
+	p.Interval = d.Get("interval").(string)
+	p.PoolRecord = d.Get("pool_record").(string)
+	p.Threshold = d.Get("threshold").(int)
+	for _, a := range d.Get("agents").([]interface{}) {
+		p.Agents = append(p.Agents, a.(string))
+	}
+
+	p.Type = udnssdk.TCPProbeType
+	tps := d.Get("tcp_probe").([]interface{})
+	if len(tps) >= 1 {
+		if len(tps) > 1 {
+			return p, fmt.Errorf("tcp_probe: only 0 or 1 blocks alowed, got: %#v", len(tps))
+		}
+		p.Details = makeTCPProbeDetails(tps[0])
+	}
+
+	return p, nil
+}
+
+func makeTCPProbeDetails(configured interface{}) *udnssdk.ProbeDetailsDTO {
+	data := configured.(map[string]interface{})
+	// Convert limits from flattened set format to mapping.
+	ls := make(map[string]udnssdk.ProbeDetailsLimitDTO)
+	for _, limit := range data["limit"].(*schema.Set).List() {
+		l := limit.(map[string]interface{})
+		name := l["name"].(string)
+		ls[name] = *makeProbeDetailsLimit(l)
+	}
+	res := udnssdk.ProbeDetailsDTO{
+		Detail: udnssdk.TCPProbeDetailsDTO{
+			Limits:    ls,
+			Port:      data["port"].(int),
+			ControlIP: data["control_ip"].(string),
+		},
+	}
+	return &res
+}
+
+func populateResourceDataFromTCPProbe(p udnssdk.ProbeInfoDTO, d *schema.ResourceData) error {
+	d.Set("pool_record", p.PoolRecord)
+	d.Set("interval", p.Interval)
+	d.Set("agents", p.Agents)
+	d.Set("threshold", p.Threshold)
+
+	if p.Details == nil {
+		return nil
+	}
+	pd, err := p.Details.TCPProbeDetails()
+	if err != nil {
+		return fmt.Errorf("ProbeInfo.details could not be unmarshalled: %v, Details: %#v", err, p.Details)
+	}
+	tp := map[string]interface{}{
+		"port":       pd.Port,
+		"control_ip": pd.ControlIP,
+		"limit":      makeSetFromLimits(pd.Limits),
+	}
+
+	err = d.Set("tcp_probe", []map[string]interface{}{tp})
+	if err != nil {
+		return fmt.Errorf("tcp_probe set failed: %v, from %#v", err, tp)
+	}
+	return nil
+}
+
+// State function to seperate id into appropriate name and zone
+func resourceUltradnsProbeTCPImport(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return setProbeResourceAndParseId(d)
+}
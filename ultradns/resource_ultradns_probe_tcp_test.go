@@ -0,0 +1,87 @@
+package ultradns
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	udnssdk "github.com/ultradns/ultradns-sdk-go"
+)
+
+func TestMakeTCPProbeDetails(t *testing.T) {
+	details := makeTCPProbeDetails(map[string]interface{}{
+		"port":       6379,
+		"control_ip": "10.0.0.100",
+		"limit": schema.NewSet(hashLimits, []interface{}{
+			map[string]interface{}{"name": "connect", "warning": 20, "critical": 25, "fail": 30},
+		}),
+	})
+	assert.Equal(t, udnssdk.TCPProbeDetailsDTO{
+		Port:      6379,
+		ControlIP: "10.0.0.100",
+		Limits: map[string]udnssdk.ProbeDetailsLimitDTO{
+			"connect": {Warning: 20, Critical: 25, Fail: 30},
+		},
+	}, details.Detail, true)
+}
+
+func TestResourceUltradnsProbeTCPImport(t *testing.T) {
+	d := resourceUltradnsProbeTCP().TestResourceData()
+	d.SetId("pool:example.com:0608485259D5AC17")
+	imported, err := resourceUltradnsProbeTCPImport(context.Background(), d, &Client{})
+	assert.Nil(t, err, true)
+	assert.Equal(t, "pool", imported[0].Get("name"), true)
+	assert.Equal(t, "example.com", imported[0].Get("zone"), true)
+
+	d.SetId("pool.example.com")
+	_, err = resourceUltradnsProbeTCPImport(context.Background(), d, &Client{})
+	assert.NotNil(t, err, true)
+}
+
+func TestResourceUltradnsProbeTCPLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	fake.addRRSet("example.com", udnssdk.RRSet{OwnerName: "pool", RRType: "A", TTL: 300, RData: []string{"10.0.0.1"}})
+	testResourceLifecycle(t, fake, resourceUltradnsProbeTCP(),
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "pool",
+			"pool_record": "10.0.0.1",
+			"agents":      []interface{}{"DALLAS", "AMSTERDAM"},
+			"interval":    "ONE_MINUTE",
+			"threshold":   2,
+			"tcp_probe": []interface{}{
+				map[string]interface{}{
+					"port": 6379,
+					"limit": []interface{}{
+						map[string]interface{}{"name": "connect", "warning": 20, "critical": 25, "fail": 30},
+					},
+				},
+			},
+		},
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "pool",
+			"pool_record": "10.0.0.1",
+			"agents":      []interface{}{"DALLAS", "NEW_YORK"},
+			"interval":    "FIVE_MINUTES",
+			"threshold":   1,
+			"tcp_probe": []interface{}{
+				map[string]interface{}{
+					"port":       25,
+					"control_ip": "10.0.0.100",
+					"limit": []interface{}{
+						map[string]interface{}{"name": "connect", "warning": 20, "critical": 25, "fail": 30},
+						map[string]interface{}{"name": "avgConnect", "warning": 10, "critical": 15, "fail": 20},
+					},
+				},
+			},
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			assert.Equal(t, strings.Split(d.Id(), ":")[2], d.Get("tcp_id"), true)
+			assert.Equal(t, 25, d.Get("tcp_probe.0.port"), true)
+			assert.Equal(t, "10.0.0.100", d.Get("tcp_probe.0.control_ip"), true)
+			assert.Equal(t, 2, d.Get("tcp_probe.0.limit").(*schema.Set).Len(), true)
+		})
+}
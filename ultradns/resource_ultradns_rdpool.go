@@ -1,31 +1,43 @@
 package ultradns
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strings"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	udnssdk "github.com/ultradns/ultradns-sdk-go"
 )
 
 func resourceUltradnsRdpool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceUltradnsRdpoolCreate,
-		Read:   resourceUltradnsRdpoolRead,
-		Update: resourceUltradnsRdpoolUpdate,
-		Delete: resourceUltradnsRdpoolDelete,
+		CreateContext: resourceUltradnsRdpoolCreate,
+		ReadContext:   resourceUltradnsRdpoolRead,
+		UpdateContext: resourceUltradnsRdpoolUpdate,
+		DeleteContext: resourceUltradnsRdpoolDelete,
+
+		CustomizeDiff: customizeDiffProviderDefaults("zone", "ttl", "description"),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Importer: &schema.ResourceImporter{
-			State: resourceUltradnsRdpoolImport,
+			StateContext: resourceUltradnsRdpoolImport,
 		},
 
 		Schema: map[string]*schema.Schema{
 			// Required
 			"zone": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"name": {
@@ -53,12 +65,13 @@ func resourceUltradnsRdpool() *schema.Resource {
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(0, 255),
 			},
 			"ttl": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				Default:  3600,
+				Computed: true,
 			},
 			// Computed
 			"hostname": {
@@ -71,34 +84,38 @@ func resourceUltradnsRdpool() *schema.Resource {
 
 // CRUD Operations
 
-func resourceUltradnsRdpoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceUltradnsRdpoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] ultradns_rdpool create")
-	client := meta.(*udnssdk.Client)
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := newRRSetResourceFromRdpool(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	log.Printf("[INFO] ultradns_rdpool create: %#v", r)
-	_, err = client.RRSets.Create(r.RRSetKey(), r.RRSet())
+	log.Printf("[INFO] ultradns_rdpool create: %s", r.ID())
+	resp, err := client.RRSets.Create(r.RRSetKey(), r.RRSet())
 	if err != nil {
-		return fmt.Errorf("create failed: %#v -> %v", r, err)
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
 	}
 
 	d.SetId(r.ID())
 	log.Printf("[INFO] ultradns_rdpool.id: %v", d.Id())
 
-	return resourceUltradnsRdpoolRead(d, meta)
+	return resourceUltradnsRdpoolRead(ctx, d, meta)
 }
 
-func resourceUltradnsRdpoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceUltradnsRdpoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] ultradns_rdpool read")
-	client := meta.(*udnssdk.Client)
+	client := meta.(*Client).withContext(ctx)
 
 	rr, err := newRRSetResourceFromRdpool(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	rrsets, err := client.RRSets.Select(rr.RRSetKey())
@@ -111,14 +128,17 @@ func resourceUltradnsRdpoolRead(d *schema.ResourceData, meta interface{}) error
 					d.SetId("")
 					return nil
 				}
-				return fmt.Errorf("resource not found: %v", err)
+				return diag.Errorf("resource not found: %v", err)
 			}
 		}
-		return fmt.Errorf("resource not found: %v", err)
+		return diag.Errorf("resource not found: %v", err)
 	}
 
 	r := rrsets[0]
-	return populateResourcesFromRDPool(r, d)
+	if err := populateResourcesFromRDPool(r, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 
 }
 
@@ -141,7 +161,6 @@ func populateResourcesFromRDPool(r udnssdk.RRSet, d *schema.ResourceData) error
 		return fmt.Errorf("RRSet.profile missing: invalid RDPool schema in: %#v", r)
 	}
 
-	log.Infof("Profile = %+v", r.Profile)
 	p, err := r.Profile.RDPoolProfile()
 	if err != nil {
 		return fmt.Errorf("RRSet.profile could not be unmarshalled: %v\n", err)
@@ -160,37 +179,45 @@ func populateResourcesFromRDPool(r udnssdk.RRSet, d *schema.ResourceData) error
 	return nil
 }
 
-func resourceUltradnsRdpoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceUltradnsRdpoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] ultradns_rdpool update")
-	client := meta.(*udnssdk.Client)
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := newRRSetResourceFromRdpool(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	log.Printf("[INFO] ultradns_rdpool update: %+v", r)
-	_, err = client.RRSets.Update(r.RRSetKey(), r.RRSet())
+	log.Printf("[INFO] ultradns_rdpool update: %s", r.ID())
+	resp, err := client.RRSets.Update(r.RRSetKey(), r.RRSet())
 	if err != nil {
-		return fmt.Errorf("resource update failed: %v", err)
+		return diag.Errorf("resource update failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("resource update failed: %v", err)
 	}
 
-	return resourceUltradnsRdpoolRead(d, meta)
+	return resourceUltradnsRdpoolRead(ctx, d, meta)
 }
 
-func resourceUltradnsRdpoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceUltradnsRdpoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] ultradns_rdpool delete")
-	client := meta.(*udnssdk.Client)
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := newRRSetResourceFromRdpool(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	log.Printf("[INFO] ultradns_rdpool delete: %+v", r)
-	_, err = client.RRSets.Delete(r.RRSetKey())
+	log.Printf("[INFO] ultradns_rdpool delete: %s", r.ID())
+	resp, err := client.RRSets.Delete(r.RRSetKey())
+	if err != nil {
+		return diag.Errorf("resource delete failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return fmt.Errorf("resource delete failed: %v", err)
+		return diag.Errorf("resource delete failed: %v", err)
 	}
 
 	return nil
@@ -210,7 +237,6 @@ func newRRSetResourceFromRdpool(d *schema.ResourceData) (rRSetResource, error) {
 	}
 	if attr, ok := d.GetOk("rdata"); ok {
 		rdata := attr.(*schema.Set).List()
-		log.Infof("%v", rdata)
 		r.RData = make([]string, len(rdata))
 		for i, j := range rdata {
 			r.RData[i] = j.(string)
@@ -231,6 +257,6 @@ func newRRSetResourceFromRdpool(d *schema.ResourceData) (rRSetResource, error) {
 
 // State Function to seperate id into appropriate name and zone
 func resourceUltradnsRdpoolImport(
-	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	return setResourceAndParseId(d)
 }
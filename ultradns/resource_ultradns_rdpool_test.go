@@ -1,6 +1,7 @@
 package ultradns
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -8,9 +9,9 @@ import (
 	"reflect"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	udnssdk "github.com/ultradns/ultradns-sdk-go"
@@ -76,7 +77,8 @@ func setResourceRecordRDPool() (resourceRecord *schema.Resource) {
 			// Required
 			"zone": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"name": {
@@ -104,12 +106,13 @@ func setResourceRecordRDPool() (resourceRecord *schema.Resource) {
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(0, 255),
 			},
 			"ttl": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				Default:  3600,
+				Computed: true,
 			},
 			// Computed
 			"hostname": {
@@ -174,9 +177,9 @@ func TestResourceUltradnsRDPoolCreate(t *testing.T) {
 	actualData := resourceRecordObject.TestResourceData()
 	mocked := mockUltraDNSRecordRDPool{}
 
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 
 	actualData.Set("name", "test.provider.ultradns.net")
 	actualData.Set("ttl", 3600)
@@ -194,7 +197,7 @@ func TestResourceUltradnsRDPoolCreate(t *testing.T) {
 	expectedData.Set("description", "testing")
 	expectedData.Set("hostname", "test.provider.ultradns.net.test.provider.ultradns.net")
 
-	resourceUltradnsRdpoolCreate(actualData, client)
+	resourceUltradnsRdpoolCreate(context.Background(), actualData, client)
 	compareResourceDataRDPool(t, expectedData, actualData)
 }
 
@@ -205,9 +208,9 @@ func TestResourceUltradnsRDPoolRead(t *testing.T) {
 	actualData := resourceRecordObject.TestResourceData()
 	mocked := mockUltraDNSRecordRDPool{}
 
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 
 	actualData.Set("name", "test.provider.ultradns.net")
 	actualData.Set("zone", "test.provider.ultradns.net")
@@ -220,7 +223,7 @@ func TestResourceUltradnsRDPoolRead(t *testing.T) {
 	expectedData.Set("description", "testing")
 	expectedData.Set("hostname", "test.provider.ultradns.net.test.provider.ultradns.net")
 
-	resourceUltradnsRdpoolRead(actualData, client)
+	resourceUltradnsRdpoolRead(context.Background(), actualData, client)
 	compareResourceDataRDPool(t, expectedData, actualData)
 
 }
@@ -232,9 +235,9 @@ func TestResourceUltradnsRDPoolUpdate(t *testing.T) {
 	actualData := resourceRecordObject.TestResourceData()
 	mocked := mockUltraDNSRecordRDPool{}
 
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 
 	actualData.Set("name", "test.provider.ultradns.net")
 	actualData.Set("ttl", 3600)
@@ -252,7 +255,7 @@ func TestResourceUltradnsRDPoolUpdate(t *testing.T) {
 	expectedData.Set("description", "testing")
 	expectedData.Set("hostname", "test.provider.ultradns.net.test.provider.ultradns.net")
 
-	resourceUltradnsRdpoolUpdate(actualData, client)
+	resourceUltradnsRdpoolUpdate(context.Background(), actualData, client)
 	compareResourceDataRDPool(t, expectedData, actualData)
 
 }
@@ -264,9 +267,9 @@ func TestResourceUltradnsRDPoolDelete(t *testing.T) {
 	actualData := resourceRecordObject.TestResourceData()
 	mocked := mockUltraDNSRecordRDPool{}
 
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 
 	actualData.Set("name", "test.provider.ultradns.net")
 	actualData.Set("ttl", 3600)
@@ -284,20 +287,20 @@ func TestResourceUltradnsRDPoolDelete(t *testing.T) {
 	expectedData.Set("description", "testing")
 	expectedData.Set("hostname", "test.provider.ultradns.net.test.provider.ultradns.net")
 
-	resourceUltradnsRdpoolDelete(actualData, client)
+	resourceUltradnsRdpoolDelete(context.Background(), actualData, client)
 	compareResourceDataRDPool(t, expectedData, actualData)
 
 }
 
 func TestResourceUltradnsRDPoolImport(t *testing.T) {
 	mocked := mockUltraDNSRecordRDPool{}
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 	resourceRecordObj := setResourceRecordRDPool()
 	d := resourceRecordObj.TestResourceData()
 	d.SetId("test:test.provider.ultradns.net:A")
-	newRecordData, _ := resourceUltradnsRdpoolImport(d, client)
+	newRecordData, _ := resourceUltradnsRdpoolImport(context.Background(), d, client)
 	assert.Equal(t, newRecordData[0].Get("name"), "test", true)
 	assert.Equal(t, newRecordData[0].Get("zone"), "test.provider.ultradns.net", true)
 
@@ -306,13 +309,13 @@ func TestResourceUltradnsRDPoolImport(t *testing.T) {
 //Testcase to check fail case
 func TestResourceUltradnsRDPoolImportFailCase(t *testing.T) {
 	mocked := mockUltraDNSRecordRDPool{}
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 	resourceRecordObj := setResourceRecordRDPool()
 	d := resourceRecordObj.TestResourceData()
 	d.SetId("testabc.test.provider.ultradns.net")
-	_, err := resourceUltradnsRdpoolImport(d, client)
+	_, err := resourceUltradnsRdpoolImport(context.Background(), d, client)
 	log.Errorf("Error: %+v", err)
 	assert.NotNil(t, err, true)
 
@@ -375,6 +378,33 @@ func TestPopulateResourcesFromRDPool(t *testing.T) {
 	compareResourceDataRDPool(t, expectedData, actualData)
 }
 
+func TestResourceUltradnsRDPoolLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	testResourceLifecycle(t, fake, resourceUltradnsRdpool(),
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "rdpool",
+			"ttl":         300,
+			"order":       "ROUND_ROBIN",
+			"description": "resource distribution",
+			"rdata":       []interface{}{"10.0.0.1", "10.0.0.2"},
+		},
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "rdpool",
+			"ttl":         600,
+			"order":       "FIXED",
+			"description": "resource distribution, fixed",
+			"rdata":       []interface{}{"10.0.0.2", "10.0.0.3"},
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			rrset, ok := fake.rrset("example.com", "A", "rdpool")
+			assert.True(t, ok, true)
+			assert.ElementsMatch(t, []string{"10.0.0.2", "10.0.0.3"}, rrset.RData, true)
+			assert.Equal(t, "FIXED", rrset.Profile["order"], true)
+		})
+}
+
 func TestAccUltradnsRdpool(t *testing.T) {
 	var record udnssdk.RRSet
 	domain, _ := os.LookupEnv("ULTRADNS_DOMAIN")
@@ -1,18 +1,20 @@
 package ultradns
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	udnssdk "github.com/ultradns/ultradns-sdk-go"
 )
 
 func newRRSetResource(d *schema.ResourceData) (rRSetResource, error) {
-	log.Infof("Schema  = %+v", d)
 	r := rRSetResource{}
 
 	// TODO: return error if required attributes aren't ok
@@ -48,7 +50,6 @@ func populateResourceDataFromRRSet(r []udnssdk.RRSet, d *schema.ResourceData) er
 	for _, rrset := range r {
 		zone := d.Get("zone")
 		typ := d.Get("type")
-		log.Infof("RRTYPE: %v", rrset.RRType)
 		if (typ != (strings.Split(rrset.RRType, " "))[0]) && (typ != "TXT") {
 			continue
 		}
@@ -56,7 +57,6 @@ func populateResourceDataFromRRSet(r []udnssdk.RRSet, d *schema.ResourceData) er
 		//setting type
 		d.Set("type", typ)
 
-		log.Infof("typ = %s %s %s", typ, zone, strconv.Itoa(rrset.TTL))
 		// ttl
 		d.Set("ttl", strconv.Itoa(rrset.TTL))
 		// rdata
@@ -98,16 +98,25 @@ func populateResourceDataFromRRSet(r []udnssdk.RRSet, d *schema.ResourceData) er
 
 func resourceUltradnsRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceUltraDNSRecordCreate,
-		Read:   resourceUltraDNSRecordRead,
-		Update: resourceUltraDNSRecordUpdate,
-		Delete: resourceUltraDNSRecordDelete,
+		CreateContext: resourceUltraDNSRecordCreate,
+		ReadContext:   resourceUltraDNSRecordRead,
+		UpdateContext: resourceUltraDNSRecordUpdate,
+		DeleteContext: resourceUltraDNSRecordDelete,
+
+		CustomizeDiff: customizeDiffProviderDefaults("zone", "ttl"),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			// Required
 			"zone": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"name": {
@@ -129,7 +138,7 @@ func resourceUltradnsRecord() *schema.Resource {
 			"ttl": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "3600",
+				Computed: true,
 			},
 			// Computed
 			"hostname": {
@@ -139,42 +148,45 @@ func resourceUltradnsRecord() *schema.Resource {
 		},
 
 		Importer: &schema.ResourceImporter{
-			State: resourceUltradnsRecordImport,
+			StateContext: resourceUltradnsRecordImport,
 		},
 	}
 }
 
 // CRUD Operations
 
-func resourceUltraDNSRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltraDNSRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := newRRSetResource(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	log.Printf("[INFO] ultradns_record create: %+v", r)
-	_, err = client.RRSets.Create(r.RRSetKey(), r.RRSet())
+	log.Printf("[INFO] ultradns_record create: %s", r.ID())
+	resp, err := client.RRSets.Create(r.RRSetKey(), r.RRSet())
 	if err != nil {
-		return fmt.Errorf("create failed: %v", err)
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
 	}
 	d.SetId(r.ID())
 	log.Printf("[INFO] ultradns_record.id: %v", d.Id())
 
-	return resourceUltraDNSRecordRead(d, meta)
+	return resourceUltraDNSRecordRead(ctx, d, meta)
 }
 
-func resourceUltraDNSRecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltraDNSRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := newRRSetResource(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	rrsets, err := client.RRSets.Select(r.RRSetKey())
-	log.Infof("RRSET INFO: %v", rrsets)
 	if err != nil {
 		uderr, ok := err.(*udnssdk.ErrorResponseList)
 		if ok {
@@ -184,44 +196,55 @@ func resourceUltraDNSRecordRead(d *schema.ResourceData, meta interface{}) error
 					d.SetId("")
 					return nil
 				}
-				return fmt.Errorf("not found: %v", err)
+				return diag.Errorf("not found: %v", err)
 			}
 		}
-		return fmt.Errorf("not found: %v", err)
+		return diag.Errorf("not found: %v", err)
 	}
 	rec := rrsets
-	return populateResourceDataFromRRSet(rec, d)
+	if err := populateResourceDataFromRRSet(rec, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-func resourceUltraDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltraDNSRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := newRRSetResource(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	log.Printf("[INFO] ultradns_record update: %+v", r)
-	_, err = client.RRSets.Update(r.RRSetKey(), r.RRSet())
+	log.Printf("[INFO] ultradns_record update: %s", r.ID())
+	resp, err := client.RRSets.Update(r.RRSetKey(), r.RRSet())
 	if err != nil {
-		return fmt.Errorf("update failed: %v", err)
+		return diag.Errorf("update failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("update failed: %v", err)
 	}
 
-	return resourceUltraDNSRecordRead(d, meta)
+	return resourceUltraDNSRecordRead(ctx, d, meta)
 }
 
-func resourceUltraDNSRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltraDNSRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := newRRSetResource(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	log.Printf("[INFO] ultradns_record delete: %+v", r)
-	_, err = client.RRSets.Delete(r.RRSetKey())
+	log.Printf("[INFO] ultradns_record delete: %s", r.ID())
+	resp, err := client.RRSets.Delete(r.RRSetKey())
+	if err != nil {
+		return diag.Errorf("delete failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return fmt.Errorf("delete failed: %v", err)
+		return diag.Errorf("delete failed: %v", err)
 	}
 
 	return nil
@@ -229,6 +252,6 @@ func resourceUltraDNSRecordDelete(d *schema.ResourceData, meta interface{}) erro
 
 // State Function to seperate id into appropriate name and zone
 func resourceUltradnsRecordImport(
-	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	return setResourceAndParseId(d)
 }
@@ -1,15 +1,16 @@
 package ultradns
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
 	"reflect"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	udnssdk "github.com/ultradns/ultradns-sdk-go"
@@ -61,7 +62,8 @@ func setResourceRecord() (resourceRecord *schema.Resource) {
 			// Required
 			"zone": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"name": {
@@ -84,7 +86,7 @@ func setResourceRecord() (resourceRecord *schema.Resource) {
 			"ttl": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "3600",
+				Computed: true,
 			},
 			// Computed
 			"hostname": {
@@ -205,9 +207,9 @@ func TestResourceUltradnsRecordCreate(t *testing.T) {
 	actualData := resourceRecordObject.TestResourceData()
 	mocked := mockUltraDNSRecord{}
 
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 
 	actualData.Set("name", "test.provider.ultradns.net")
 	actualData.Set("ttl", "3600")
@@ -221,7 +223,7 @@ func TestResourceUltradnsRecordCreate(t *testing.T) {
 	expectedData.Set("type", "A")
 	expectedData.Set("zone", "test.provider.ultradns.net")
 
-	resourceUltraDNSRecordCreate(actualData, client)
+	resourceUltraDNSRecordCreate(context.Background(), actualData, client)
 	compareResourceData(t, expectedData, actualData)
 
 }
@@ -233,9 +235,9 @@ func TestResourceUltradnsRecordUpdate(t *testing.T) {
 	actualData := resourceRecordObject.TestResourceData()
 	mocked := mockUltraDNSRecord{}
 
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 
 	actualData.Set("name", "test.provider.ultradns.net")
 	actualData.Set("ttl", "3600")
@@ -249,7 +251,7 @@ func TestResourceUltradnsRecordUpdate(t *testing.T) {
 	expectedData.Set("rdata", []string{"10.0.0.1"})
 	expectedData.Set("zone", "test.provider.ultradns.net")
 
-	resourceUltraDNSRecordUpdate(actualData, client)
+	resourceUltraDNSRecordUpdate(context.Background(), actualData, client)
 	compareResourceData(t, expectedData, actualData)
 
 }
@@ -261,9 +263,9 @@ func TestResourceUltradnsRecordDelete(t *testing.T) {
 	actualData := resourceRecordObject.TestResourceData()
 	mocked := mockUltraDNSRecord{}
 
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 
 	actualData.Set("name", "test.provider.ultradns.net")
 	actualData.Set("ttl", "3600")
@@ -277,7 +279,7 @@ func TestResourceUltradnsRecordDelete(t *testing.T) {
 	expectedData.Set("type", "A")
 	expectedData.Set("zone", "test.provider.ultradns.net")
 
-	resourceUltraDNSRecordDelete(actualData, client)
+	resourceUltraDNSRecordDelete(context.Background(), actualData, client)
 	compareResourceData(t, expectedData, actualData)
 
 }
@@ -285,14 +287,14 @@ func TestResourceUltradnsRecordDelete(t *testing.T) {
 //Testcase to check proper split of iD into appropriate fields
 func TestResourceUltradnsRecordImport(t *testing.T) {
 	mocked := mockUltraDNSRecord{}
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 	resourceRecordObj := setResourceRecord()
 	d := resourceRecordObj.TestResourceData()
 
 	d.SetId("test:test.provider.ultradns.net:MX")
-	newRecordData, _ := resourceUltradnsRecordImport(d, client)
+	newRecordData, _ := resourceUltradnsRecordImport(context.Background(), d, client)
 	assert.Equal(t, newRecordData[0].Get("name"), "test", true)
 	assert.Equal(t, newRecordData[0].Get("zone"), "test.provider.ultradns.net", true)
 	assert.Equal(t, newRecordData[0].Get("type"), "MX", true)
@@ -302,23 +304,71 @@ func TestResourceUltradnsRecordImport(t *testing.T) {
 //Testcase to check fail case
 func TestResourceUltradnsRecordImportFailCase(t *testing.T) {
 	mocked := mockUltraDNSRecord{}
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 	resourceRecordObj := setResourceRecord()
 	d := resourceRecordObj.TestResourceData()
 	d.SetId("testabc.test.provider.ultradns.net")
-	_, err := resourceUltradnsRecordImport(d, client)
+	_, err := resourceUltradnsRecordImport(context.Background(), d, client)
 	log.Errorf("Error: %+v", err)
 	assert.NotNil(t, err, true)
 
 	// Case1 when only one delimiter are there
 	d.SetId("test:test.provider.ultradns.net.")
-	_, err = resourceUltradnsProbeHTTPImport(d, udnssdk.Client{})
+	_, err = resourceUltradnsProbeHTTPImport(context.Background(), d, udnssdk.Client{})
 	log.Errorf("ERROR: %+v", err)
 	assert.NotNil(t, err, true)
 }
 
+func TestResourceUltradnsRecordLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	testResourceLifecycle(t, fake, resourceUltradnsRecord(),
+		map[string]interface{}{
+			"zone":  "example.com",
+			"name":  "www",
+			"type":  "A",
+			"ttl":   "300",
+			"rdata": []interface{}{"10.0.0.1"},
+		},
+		map[string]interface{}{
+			"zone":  "example.com",
+			"name":  "www",
+			"type":  "A",
+			"ttl":   "600",
+			"rdata": []interface{}{"10.0.0.1", "10.0.0.2"},
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			assert.Equal(t, "www.example.com.", d.Get("hostname"), true)
+			rrset, ok := fake.rrset("example.com", "A", "www")
+			assert.True(t, ok, true)
+			assert.ElementsMatch(t, []string{"10.0.0.1", "10.0.0.2"}, rrset.RData, true)
+		})
+}
+
+func TestResourceUltradnsRecordLifecycleTXT(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	fake.async = true
+	testResourceLifecycle(t, fake, resourceUltradnsRecord(),
+		map[string]interface{}{
+			"zone":  "example.com",
+			"name":  "txt",
+			"type":  "TXT",
+			"ttl":   "300",
+			"rdata": []interface{}{"v=spf1 -all"},
+		},
+		map[string]interface{}{
+			"zone":  "example.com",
+			"name":  "txt",
+			"type":  "TXT",
+			"ttl":   "300",
+			"rdata": []interface{}{"v=spf1 mx -all"},
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			assert.True(t, d.Get("rdata").(*schema.Set).Contains("v=spf1 mx -all"), true)
+		})
+}
+
 func TestAccUltradnsRecord(t *testing.T) {
 	var record udnssdk.RRSet
 	domain, _ := os.LookupEnv("ULTRADNS_DOMAIN")
@@ -391,7 +441,7 @@ func TestAccUltradnsRecordTXT(t *testing.T) {
 }
 
 func testAccRecordCheckDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*udnssdk.Client)
+	client := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ultradns_record" {
@@ -0,0 +1,354 @@
+package ultradns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/ultradns/ultradns-sdk-go"
+)
+
+func resourceUltradnsSbpool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUltradnsSbpoolCreate,
+		ReadContext:   resourceUltradnsSbpoolRead,
+		UpdateContext: resourceUltradnsSbpoolUpdate,
+		DeleteContext: resourceUltradnsSbpoolDelete,
+
+		CustomizeDiff: customizeDiffProviderDefaults("zone", "ttl", "description"),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUltradnsSbpoolImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"rdata": {
+				Type:     schema.TypeSet,
+				Set:      hashRdatas,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"host": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// Optional
+						"failover_delay": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+							// Valid: 0-30
+							// Units: Minutes
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+						"run_probes": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "NORMAL",
+							ValidateFunc: validation.StringInSlice([]string{
+								"NORMAL",
+								"ACTIVE",
+								"INACTIVE",
+							}, false),
+						},
+						"threshold": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+					},
+				},
+			},
+			// Optional
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"run_probes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"act_on_probes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ROUND_ROBIN",
+				ValidateFunc: validation.StringInSlice([]string{
+					"ROUND_ROBIN",
+					"FIXED",
+					"RANDOM",
+				}, false),
+			},
+			"max_active": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				// Valid: 0 <= i <= len(rdata)
+			},
+			"max_served": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				// Valid: 0 <= i <= max_active
+			},
+			"backup_record": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rdata": {
+							Type:     schema.TypeString,
+							Required: true,
+							// Valid: IPv4 address or CNAME
+						},
+						"failover_delay": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							// Valid: 0-30
+							// Units: Minutes
+						},
+					},
+				},
+			},
+			// Computed
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// CRUD Operations
+
+func resourceUltradnsSbpoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := newRRSetResourceFromSbpool(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] ultradns_sbpool create: %s", r.ID())
+	resp, err := client.RRSets.Create(r.RRSetKey(), r.RRSet())
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	d.SetId(r.ID())
+	log.Printf("[INFO] ultradns_sbpool.id: %v", d.Id())
+
+	return resourceUltradnsSbpoolRead(ctx, d, meta)
+}
+
+func resourceUltradnsSbpoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	rr, err := newRRSetResourceFromSbpool(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rrsets, err := client.RRSets.Select(rr.RRSetKey())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("resource not found: %v", err)
+	}
+
+	r := rrsets[0]
+
+	zone := d.Get("zone")
+	// ttl
+	d.Set("ttl", r.TTL)
+	// hostname
+	if r.OwnerName == "" {
+		d.Set("hostname", zone)
+	} else {
+		if strings.HasSuffix(r.OwnerName, ".") {
+			d.Set("hostname", r.OwnerName)
+		} else {
+			d.Set("hostname", fmt.Sprintf("%s.%s", r.OwnerName, zone))
+		}
+	}
+
+	if r.Profile == nil {
+		return diag.Errorf("RRSet.profile missing: invalid SBPool schema in: %#v", r)
+	}
+	p, err := r.Profile.SBPoolProfile()
+	if err != nil {
+		return diag.Errorf("RRSet.profile could not be unmarshalled: %v\n", err)
+	}
+
+	// Set simple values
+	d.Set("description", p.Description)
+	d.Set("run_probes", p.RunProbes)
+	d.Set("act_on_probes", p.ActOnProbes)
+	d.Set("order", p.Order)
+	d.Set("max_active", p.MaxActive)
+	d.Set("max_served", p.MaxServed)
+
+	err = d.Set("backup_record", zipBackupRecords(p.BackupRecords))
+	if err != nil {
+		return diag.Errorf("backup_record set failed: %v", err)
+	}
+	err = d.Set("rdata", makeSetFromRdata(r.RData, p.RDataInfo))
+	if err != nil {
+		return diag.Errorf("rdata set failed: %v", err)
+	}
+	return nil
+}
+
+func resourceUltradnsSbpoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := newRRSetResourceFromSbpool(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] ultradns_sbpool update: %s", r.ID())
+	resp, err := client.RRSets.Update(r.RRSetKey(), r.RRSet())
+	if err != nil {
+		return diag.Errorf("resource update failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("resource update failed: %v", err)
+	}
+
+	return resourceUltradnsSbpoolRead(ctx, d, meta)
+}
+
+func resourceUltradnsSbpoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := newRRSetResourceFromSbpool(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] ultradns_sbpool delete: %s", r.ID())
+	resp, err := client.RRSets.Delete(r.RRSetKey())
+	if err != nil {
+		return diag.Errorf("resource delete failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("resource delete failed: %v", err)
+	}
+
+	return nil
+}
+
+// State Function to seperate id into appropriate name and zone
+func resourceUltradnsSbpoolImport(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return setResourceAndParseId(d)
+}
+
+// Resource Helpers
+
+func newRRSetResourceFromSbpool(d *schema.ResourceData) (rRSetResource, error) {
+	rDataRaw := d.Get("rdata").(*schema.Set).List()
+	r := rRSetResource{
+		// "The only valid rrtype value for SiteBacker or Traffic Controller pools is A"
+		// per https://portal.ultradns.com/static/docs/REST-API_User_Guide.pdf
+		RRType:    "A",
+		Zone:      d.Get("zone").(string),
+		OwnerName: d.Get("name").(string),
+		TTL:       d.Get("ttl").(int),
+		RData:     unzipRdataHosts(rDataRaw),
+	}
+
+	profile := udnssdk.SBPoolProfile{
+		Context:       udnssdk.SBPoolSchema,
+		ActOnProbes:   d.Get("act_on_probes").(bool),
+		Description:   d.Get("description").(string),
+		Order:         d.Get("order").(string),
+		MaxActive:     d.Get("max_active").(int),
+		MaxServed:     d.Get("max_served").(int),
+		RunProbes:     d.Get("run_probes").(bool),
+		RDataInfo:     unzipRdataInfos(rDataRaw),
+		BackupRecords: unzipBackupRecords(d.Get("backup_record").([]interface{})),
+	}
+
+	rp := profile.RawProfile()
+	r.Profile = rp
+
+	return r, nil
+}
+
+func unzipBackupRecords(configured []interface{}) []udnssdk.BackupRecord {
+	brs := make([]udnssdk.BackupRecord, 0, len(configured))
+	for _, brRaw := range configured {
+		data := brRaw.(map[string]interface{})
+		brs = append(brs, udnssdk.BackupRecord{
+			RData:         data["rdata"].(string),
+			FailoverDelay: data["failover_delay"].(int),
+		})
+	}
+	return brs
+}
+
+func zipBackupRecords(brs []udnssdk.BackupRecord) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(brs))
+	for _, br := range brs {
+		result = append(result, map[string]interface{}{
+			"rdata":          br.RData,
+			"failover_delay": br.FailoverDelay,
+		})
+	}
+	return result
+}
@@ -0,0 +1,109 @@
+package ultradns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	udnssdk "github.com/ultradns/ultradns-sdk-go"
+)
+
+func TestNewRRSetResourceFromSbpool(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceUltradnsSbpool().Schema, map[string]interface{}{
+		"zone":        "example.com",
+		"name":        "sbpool",
+		"ttl":         300,
+		"description": "site backer",
+		"rdata": []interface{}{
+			map[string]interface{}{"host": "10.0.0.1", "priority": 1, "threshold": 1, "state": "ACTIVE"},
+		},
+		"order":      "FIXED",
+		"max_active": 1,
+		"max_served": 1,
+		"backup_record": []interface{}{
+			map[string]interface{}{"rdata": "10.0.0.8", "failover_delay": 5},
+			map[string]interface{}{"rdata": "10.0.0.9"},
+		},
+	})
+
+	r, err := newRRSetResourceFromSbpool(d)
+	assert.Nil(t, err, true)
+	assert.Equal(t, "sbpool:example.com:A", r.ID(), true)
+	assert.Equal(t, []string{"10.0.0.1"}, r.RData, true)
+
+	assert.Equal(t, udnssdk.ProfileSchema(udnssdk.SBPoolSchema), r.Profile["@context"], true)
+	assert.Equal(t, "FIXED", r.Profile["order"], true)
+	assert.EqualValues(t, 1, r.Profile["maxActive"], true)
+	assert.EqualValues(t, 1, r.Profile["maxServed"], true)
+	assert.Equal(t, 2, len(r.Profile["backupRecords"].([]interface{})), true)
+	rdataInfo := r.Profile["rdataInfo"].([]interface{})
+	assert.Equal(t, 1, len(rdataInfo), true)
+	assert.Equal(t, "ACTIVE", rdataInfo[0].(map[string]interface{})["state"], true)
+	assert.Nil(t, rdataInfo[0].(map[string]interface{})["weight"], true)
+}
+
+func TestResourceUltradnsSbpoolImport(t *testing.T) {
+	d := resourceUltradnsSbpool().TestResourceData()
+	d.SetId("sbpool:example.com:A")
+	imported, err := resourceUltradnsSbpoolImport(context.Background(), d, &Client{})
+	assert.Nil(t, err, true)
+	assert.Equal(t, "sbpool", imported[0].Get("name"), true)
+	assert.Equal(t, "example.com", imported[0].Get("zone"), true)
+
+	d.SetId("sbpool.example.com")
+	_, err = resourceUltradnsSbpoolImport(context.Background(), d, &Client{})
+	assert.NotNil(t, err, true)
+}
+
+func TestResourceUltradnsSbpoolLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	testResourceLifecycle(t, fake, resourceUltradnsSbpool(),
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "sbpool",
+			"ttl":         300,
+			"description": "site backer",
+			"rdata": []interface{}{
+				map[string]interface{}{"host": "10.0.0.1", "priority": 1, "state": "ACTIVE"},
+				map[string]interface{}{"host": "10.0.0.2", "priority": 2},
+			},
+			"run_probes":    true,
+			"act_on_probes": true,
+			"order":         "FIXED",
+			"max_active":    1,
+			"max_served":    1,
+			"backup_record": []interface{}{
+				map[string]interface{}{"rdata": "10.0.0.9", "failover_delay": 5},
+			},
+		},
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "sbpool",
+			"ttl":         600,
+			"description": "site backer, two active",
+			"rdata": []interface{}{
+				map[string]interface{}{"host": "10.0.0.1", "priority": 1, "threshold": 2},
+				map[string]interface{}{"host": "10.0.0.3", "priority": 2, "state": "INACTIVE"},
+			},
+			"run_probes":    false,
+			"act_on_probes": false,
+			"order":         "ROUND_ROBIN",
+			"max_active":    2,
+			"max_served":    2,
+			"backup_record": []interface{}{
+				map[string]interface{}{"rdata": "10.0.0.8"},
+				map[string]interface{}{"rdata": "10.0.0.9", "failover_delay": 10},
+			},
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			rrset, ok := fake.rrset("example.com", "A", "sbpool")
+			assert.True(t, ok, true)
+			assert.ElementsMatch(t, []string{"10.0.0.1", "10.0.0.3"}, rrset.RData, true)
+			assert.Equal(t, string(udnssdk.SBPoolSchema), rrset.Profile["@context"], true)
+			assert.Equal(t, 2, d.Get("rdata").(*schema.Set).Len(), true)
+			assert.Equal(t, 2, d.Get("backup_record.#"), true)
+			assert.Equal(t, "10.0.0.8", d.Get("backup_record.0.rdata"), true)
+			assert.Equal(t, 10, d.Get("backup_record.1.failover_delay"), true)
+		})
+}
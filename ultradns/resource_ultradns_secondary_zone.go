@@ -0,0 +1,240 @@
+package ultradns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceUltradnsSecondaryZone() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUltradnsSecondaryZoneCreate,
+		ReadContext:   resourceUltradnsSecondaryZoneRead,
+		UpdateContext: resourceUltradnsSecondaryZoneUpdate,
+		DeleteContext: resourceUltradnsSecondaryZoneDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUltradnsSecondaryZoneImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"primary_name_server": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: schemaNameServer(nil),
+				},
+			},
+			// Optional
+			"account_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressAccountNameCase,
+			},
+			"notification_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transfer_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transfer_status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_refresh": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"next_refresh": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// CRUD Operations
+
+func resourceUltradnsSecondaryZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	account, err := client.account(d.Get("account_name").(string))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	z := makeSecondaryZoneDTO(d, account)
+	log.Printf("[INFO] ultradns_secondary_zone create: %s", z.Properties.Name)
+	resp, err := client.createZone(z)
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	d.SetId(z.Properties.Name)
+	log.Printf("[INFO] ultradns_secondary_zone.id: %v", d.Id())
+
+	return resourceUltradnsSecondaryZoneRead(ctx, d, meta)
+}
+
+func resourceUltradnsSecondaryZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	z, err := client.getZone(d.Id())
+	if err != nil {
+		if isZoneNotFound(err) {
+			log.Printf("[WARN] ultradns_secondary_zone %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("not found: %v", err)
+	}
+	if z.Properties.Type != "SECONDARY" {
+		return diag.Errorf("zone %s is a %s zone, not a secondary zone", d.Id(), z.Properties.Type)
+	}
+
+	if err := populateResourceDataFromSecondaryZone(z, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceUltradnsSecondaryZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	z := makeSecondaryZoneDTO(d, d.Get("account_name").(string))
+	log.Printf("[INFO] ultradns_secondary_zone update: %s", d.Id())
+	resp, err := client.updateZone(z)
+	if err != nil {
+		return diag.Errorf("update failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("update failed: %v", err)
+	}
+
+	return resourceUltradnsSecondaryZoneRead(ctx, d, meta)
+}
+
+func resourceUltradnsSecondaryZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	log.Printf("[INFO] ultradns_secondary_zone delete: %s", d.Id())
+	resp, err := client.deleteZone(d.Id())
+	if err != nil {
+		if isZoneNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("delete failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("delete failed: %v", err)
+	}
+
+	return nil
+}
+
+// State function to take the zone name from the ID
+func resourceUltradnsSecondaryZoneImport(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return setZoneResourceAndParseId(d)
+}
+
+// Resource Helpers
+
+// makeSecondaryZoneDTO converts ResourceData into the request creating or
+// updating a secondary zone in account.
+func makeSecondaryZoneDTO(d *schema.ResourceData, account string) zoneDTO {
+	nameServers := nameServerList{NameServerIPList: map[string]nameServerDTO{}}
+	for i, ns := range d.Get("primary_name_server").([]interface{}) {
+		nameServers.NameServerIPList[nameServerKey(i)] = *makeNameServerDTO(ns)
+	}
+
+	return zoneDTO{
+		Properties: zoneProperties{
+			Name:        d.Get("name").(string),
+			AccountName: account,
+			Type:        "SECONDARY",
+		},
+		SecondaryCreateInfo: &secondaryZoneCreateInfo{
+			PrimaryNameServers:       nameServers,
+			NotificationEmailAddress: d.Get("notification_email").(string),
+		},
+	}
+}
+
+// populateResourceDataFromSecondaryZone takes a zone and populates the
+// ResourceData
+func populateResourceDataFromSecondaryZone(z zoneDTO, d *schema.ResourceData) error {
+	// The API answers with the canonical name; keep the configured spelling
+	if d.Get("name").(string) == "" {
+		d.Set("name", z.Properties.Name)
+	}
+	d.Set("account_name", z.Properties.AccountName)
+	d.Set("status", z.Properties.Status)
+	d.Set("notification_email", z.NotificationEmailAddress)
+
+	if z.PrimaryNameServers != nil {
+		// TSIG secrets are not returned; the configured ones are kept
+		configured := d.Get("primary_name_server").([]interface{})
+		nss := make([]map[string]interface{}, 0, len(z.PrimaryNameServers.NameServerIPList))
+		for i := 0; i < len(z.PrimaryNameServers.NameServerIPList); i++ {
+			ns, ok := z.PrimaryNameServers.NameServerIPList[nameServerKey(i)]
+			if !ok {
+				break
+			}
+			secret := ns.TSIGKeyValue
+			if secret == "" && i < len(configured) && configured[i] != nil {
+				secret = configured[i].(map[string]interface{})["tsig_key_value"].(string)
+			}
+			nss = append(nss, map[string]interface{}{
+				"ip":             ns.IP,
+				"tsig_key_name":  ns.TSIGKey,
+				"tsig_key_value": secret,
+				"tsig_algorithm": ns.TSIGAlgorithm,
+			})
+		}
+		if err := d.Set("primary_name_server", nss); err != nil {
+			return fmt.Errorf("primary_name_server set failed: %v, from %#v", err, nss)
+		}
+	}
+
+	if ts := z.TransferStatusDetails; ts != nil {
+		d.Set("transfer_status", ts.LastRefreshStatus)
+		d.Set("transfer_status_message", ts.LastRefreshStatusMessage)
+		d.Set("last_refresh", ts.LastRefresh)
+		d.Set("next_refresh", ts.NextRefresh)
+	}
+	return nil
+}
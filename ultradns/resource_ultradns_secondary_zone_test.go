@@ -0,0 +1,65 @@
+package ultradns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceUltradnsSecondaryZoneLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t)
+	testResourceLifecycle(t, fake, resourceUltradnsSecondaryZone(),
+		map[string]interface{}{
+			"name": "secondary.com.",
+			"primary_name_server": []interface{}{
+				map[string]interface{}{"ip": "192.0.2.53"},
+			},
+		},
+		map[string]interface{}{
+			"name":               "secondary.com.",
+			"notification_email": "hostmaster@example.com",
+			"primary_name_server": []interface{}{
+				map[string]interface{}{
+					"ip":             "192.0.2.53",
+					"tsig_key_name":  "transfer-key.",
+					"tsig_key_value": "c2VjcmV0",
+					"tsig_algorithm": "hmac-sha256",
+				},
+				map[string]interface{}{"ip": "198.51.100.53"},
+			},
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			assert.Equal(t, fakeAccount, d.Get("account_name"), true)
+			assert.Equal(t, "ACTIVE", d.Get("status"), true)
+			assert.Equal(t, "SUCCESSFUL", d.Get("transfer_status"), true)
+			assert.NotEmpty(t, d.Get("last_refresh"), true)
+			assert.NotEmpty(t, d.Get("next_refresh"), true)
+			assert.Equal(t, 2, d.Get("primary_name_server.#"), true)
+			assert.Equal(t, "transfer-key.", d.Get("primary_name_server.0.tsig_key_name"), true)
+			assert.Equal(t, "c2VjcmV0", d.Get("primary_name_server.0.tsig_key_value"), true)
+			assert.Equal(t, "hmac-sha256", d.Get("primary_name_server.0.tsig_algorithm"), true)
+			assert.Equal(t, "198.51.100.53", d.Get("primary_name_server.1.ip"), true)
+		})
+}
+
+func TestResourceUltradnsSecondaryZoneImportID(t *testing.T) {
+	for _, id := range []string{"", "example.com.:SECONDARY", "example .com."} {
+		d := resourceUltradnsSecondaryZone().TestResourceData()
+		d.SetId(id)
+		_, err := resourceUltradnsSecondaryZoneImport(context.Background(), d, &Client{})
+		assert.NotNil(t, err, true)
+		assert.Contains(t, err.Error(), "Wrong ID", true)
+	}
+}
+
+func TestResourceUltradnsSecondaryZoneReadNotSecondary(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+
+	d := resourceUltradnsSecondaryZone().TestResourceData()
+	d.SetId("example.com.")
+	diags := resourceUltradnsSecondaryZoneRead(context.Background(), d, fake.client(t))
+	assert.True(t, diags.HasError(), true)
+	assert.Contains(t, diags[0].Summary, "not a secondary zone", true)
+}
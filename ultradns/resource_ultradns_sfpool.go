@@ -0,0 +1,287 @@
+package ultradns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceUltradnsSfpool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUltradnsSfpoolCreate,
+		ReadContext:   resourceUltradnsSfpoolRead,
+		UpdateContext: resourceUltradnsSfpoolUpdate,
+		DeleteContext: resourceUltradnsSfpoolDelete,
+
+		CustomizeDiff: customizeDiffProviderDefaults("zone", "ttl", "description"),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUltradnsSfpoolImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"live_record": {
+				Type:     schema.TypeString,
+				Required: true,
+				// Valid: IPv4 address
+			},
+			"backup_record": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rdata": {
+							Type:     schema.TypeString,
+							Required: true,
+							// Valid: IPv4 address
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+					},
+				},
+			},
+			"monitor": schemaPoolMonitor(),
+			// Optional
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"live_record_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"live_record_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "NOT_FORCED",
+				ValidateFunc: validation.StringInSlice([]string{
+					"NOT_FORCED",
+					"FORCED",
+				}, false),
+			},
+			"region_failure_sensitivity": schemaRegionFailureSensitivity(),
+			// Computed
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// CRUD Operations
+
+func resourceUltradnsSfpoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := newRRSetResourceFromSfpool(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] ultradns_sfpool create: %s", r.ID())
+	resp, err := client.RRSets.Create(r.RRSetKey(), r.RRSet())
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	d.SetId(r.ID())
+	log.Printf("[INFO] ultradns_sfpool.id: %v", d.Id())
+
+	return resourceUltradnsSfpoolRead(ctx, d, meta)
+}
+
+func resourceUltradnsSfpoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	rr, err := newRRSetResourceFromSfpool(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rrsets, err := client.RRSets.Select(rr.RRSetKey())
+	if err != nil {
+		// 70002 means Records Not Found
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("resource not found: %v", err)
+	}
+
+	r := rrsets[0]
+
+	zone := d.Get("zone")
+	// ttl
+	d.Set("ttl", r.TTL)
+	// hostname
+	if r.OwnerName == "" {
+		d.Set("hostname", zone)
+	} else {
+		if strings.HasSuffix(r.OwnerName, ".") {
+			d.Set("hostname", r.OwnerName)
+		} else {
+			d.Set("hostname", fmt.Sprintf("%s.%s", r.OwnerName, zone))
+		}
+	}
+	if len(r.RData) > 0 {
+		d.Set("live_record", r.RData[0])
+	}
+
+	if r.Profile == nil {
+		return diag.Errorf("RRSet.profile missing: invalid SFPool schema in: %#v", r)
+	}
+	var p sfPoolProfile
+	err = decodeRawProfile(r.Profile, sfPoolSchema, &p)
+	if err != nil {
+		return diag.Errorf("RRSet.profile could not be unmarshalled: %v\n", err)
+	}
+
+	// Set simple values
+	d.Set("description", p.Description)
+	d.Set("live_record_description", p.LiveRecordDescription)
+	d.Set("live_record_state", p.LiveRecordState)
+	d.Set("region_failure_sensitivity", p.RegionFailureSensitivity)
+	d.Set("status", p.Status)
+
+	err = d.Set("monitor", zipPoolMonitor(p.Monitor))
+	if err != nil {
+		return diag.Errorf("monitor set failed: %v", err)
+	}
+	err = d.Set("backup_record", []map[string]interface{}{{
+		"rdata":       p.BackupRecord.RData,
+		"description": p.BackupRecord.Description,
+	}})
+	if err != nil {
+		return diag.Errorf("backup_record set failed: %v", err)
+	}
+	return nil
+}
+
+func resourceUltradnsSfpoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := newRRSetResourceFromSfpool(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] ultradns_sfpool update: %s", r.ID())
+	resp, err := client.RRSets.Update(r.RRSetKey(), r.RRSet())
+	if err != nil {
+		return diag.Errorf("resource update failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("resource update failed: %v", err)
+	}
+
+	return resourceUltradnsSfpoolRead(ctx, d, meta)
+}
+
+func resourceUltradnsSfpoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := newRRSetResourceFromSfpool(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] ultradns_sfpool delete: %s", r.ID())
+	resp, err := client.RRSets.Delete(r.RRSetKey())
+	if err != nil {
+		return diag.Errorf("resource delete failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("resource delete failed: %v", err)
+	}
+
+	return nil
+}
+
+// State Function to seperate id into appropriate name and zone
+func resourceUltradnsSfpoolImport(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return setResourceAndParseId(d)
+}
+
+// Resource Helpers
+
+func newRRSetResourceFromSfpool(d *schema.ResourceData) (rRSetResource, error) {
+	r := rRSetResource{
+		RRType:    "A",
+		Zone:      d.Get("zone").(string),
+		OwnerName: d.Get("name").(string),
+		TTL:       d.Get("ttl").(int),
+		RData:     []string{d.Get("live_record").(string)},
+	}
+
+	profile := sfPoolProfile{
+		Context:                  sfPoolSchema,
+		Description:              d.Get("description").(string),
+		LiveRecordState:          d.Get("live_record_state").(string),
+		LiveRecordDescription:    d.Get("live_record_description").(string),
+		RegionFailureSensitivity: d.Get("region_failure_sensitivity").(string),
+		Monitor:                  unzipPoolMonitor(d.Get("monitor").([]interface{})),
+	}
+	if br := d.Get("backup_record").([]interface{}); len(br) == 1 && br[0] != nil {
+		data := br[0].(map[string]interface{})
+		profile.BackupRecord = sfBackupRecord{
+			RData:       data["rdata"].(string),
+			Description: data["description"].(string),
+		}
+	}
+
+	rp, err := rawProfile(profile)
+	if err != nil {
+		return r, fmt.Errorf("SFPool profile could not be encoded: %v", err)
+	}
+	r.Profile = rp
+
+	return r, nil
+}
@@ -0,0 +1,81 @@
+package ultradns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceUltradnsSfpoolLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	testResourceLifecycle(t, fake, resourceUltradnsSfpool(),
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "sfpool",
+			"ttl":         300,
+			"description": "simple failover",
+			"live_record": "10.0.0.1",
+			"backup_record": []interface{}{
+				map[string]interface{}{"rdata": "10.0.0.9", "description": "standby"},
+			},
+			"monitor": []interface{}{
+				map[string]interface{}{"url": "http://www.example.com/health"},
+			},
+		},
+		map[string]interface{}{
+			"zone":                    "example.com",
+			"name":                    "sfpool",
+			"ttl":                     600,
+			"description":             "simple failover, moved",
+			"live_record":             "10.0.0.2",
+			"live_record_description": "primary",
+			"live_record_state":       "FORCED",
+			"backup_record": []interface{}{
+				map[string]interface{}{"rdata": "10.0.0.8"},
+			},
+			"monitor": []interface{}{
+				map[string]interface{}{
+					"url":              "http://www.example.com/health",
+					"method":           "POST",
+					"transmitted_data": "ping",
+					"search_string":    "pong",
+				},
+			},
+			"region_failure_sensitivity": "LOW",
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			rrset, ok := fake.rrset("example.com", "A", "sfpool")
+			assert.True(t, ok, true)
+			assert.Equal(t, []string{"10.0.0.2"}, rrset.RData, true)
+			assert.Equal(t, string(sfPoolSchema), rrset.Profile["@context"], true)
+			assert.Equal(t, "10.0.0.8", d.Get("backup_record.0.rdata"), true)
+			assert.Equal(t, "", d.Get("backup_record.0.description"), true)
+			assert.Equal(t, "POST", d.Get("monitor.0.method"), true)
+		})
+}
+
+func TestResourceUltradnsSfpoolImport(t *testing.T) {
+	d := resourceUltradnsSfpool().TestResourceData()
+	d.SetId("sfpool:example.com:A")
+	imported, err := resourceUltradnsSfpoolImport(context.Background(), d, &Client{})
+	assert.Nil(t, err, true)
+	assert.Equal(t, "sfpool", imported[0].Get("name"), true)
+	assert.Equal(t, "example.com", imported[0].Get("zone"), true)
+
+	d.SetId("sfpool.example.com")
+	_, err = resourceUltradnsSfpoolImport(context.Background(), d, &Client{})
+	assert.NotNil(t, err, true)
+}
+
+func TestResourceUltradnsSfpoolReadNotFound(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+
+	d := resourceUltradnsSfpool().TestResourceData()
+	d.Set("zone", "example.com")
+	d.Set("name", "missing")
+	d.SetId("missing:example.com:A")
+	assertNoDiagErrors(t, "read", resourceUltradnsSfpoolRead(context.Background(), d, fake.client(t)))
+	assert.Equal(t, "", d.Id(), true)
+}
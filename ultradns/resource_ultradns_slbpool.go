@@ -0,0 +1,429 @@
+package ultradns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceUltradnsSlbpool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUltradnsSlbpoolCreate,
+		ReadContext:   resourceUltradnsSlbpoolRead,
+		UpdateContext: resourceUltradnsSlbpoolUpdate,
+		DeleteContext: resourceUltradnsSlbpoolDelete,
+
+		CustomizeDiff: customizeDiffProviderDefaults("zone", "ttl", "description"),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUltradnsSlbpoolImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rdata": {
+				Type:     schema.TypeSet,
+				Set:      hashRdatas,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"host": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// Optional
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+						"probing_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"forced_state": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "NOT_FORCED",
+							ValidateFunc: validation.StringInSlice([]string{
+								"NOT_FORCED",
+								"FORCED_ACTIVE",
+								"FORCED_INACTIVE",
+							}, false),
+						},
+					},
+				},
+			},
+			"monitor": schemaPoolMonitor(),
+			"all_fail_record": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rdata": {
+							Type:     schema.TypeString,
+							Required: true,
+							// Valid: IPv4 address
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+						"serving": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			// Optional
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"region_failure_sensitivity": schemaRegionFailureSensitivity(),
+			"serving_preference": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "AUTO_SELECT",
+				ValidateFunc: validation.StringInSlice([]string{
+					"AUTO_SELECT",
+					"SERVE_PRIMARY",
+					"SERVE_ALL_FAIL",
+				}, false),
+			},
+			"response_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ROUND_ROBIN",
+				ValidateFunc: validation.StringInSlice([]string{
+					"PRIORITY_HUNT",
+					"RANDOM",
+					"ROUND_ROBIN",
+				}, false),
+			},
+			// Computed
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// CRUD Operations
+
+func resourceUltradnsSlbpoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := newRRSetResourceFromSlbpool(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] ultradns_slbpool create: %s", r.ID())
+	resp, err := client.RRSets.Create(r.RRSetKey(), r.RRSet())
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	d.SetId(r.ID())
+	log.Printf("[INFO] ultradns_slbpool.id: %v", d.Id())
+
+	return resourceUltradnsSlbpoolRead(ctx, d, meta)
+}
+
+func resourceUltradnsSlbpoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	rr, err := newRRSetResourceFromSlbpool(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rrsets, err := client.RRSets.Select(rr.RRSetKey())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("resource not found: %v", err)
+	}
+
+	r := rrsets[0]
+
+	zone := d.Get("zone")
+	// ttl
+	d.Set("ttl", r.TTL)
+	// hostname
+	if r.OwnerName == "" {
+		d.Set("hostname", zone)
+	} else {
+		if strings.HasSuffix(r.OwnerName, ".") {
+			d.Set("hostname", r.OwnerName)
+		} else {
+			d.Set("hostname", fmt.Sprintf("%s.%s", r.OwnerName, zone))
+		}
+	}
+
+	if r.Profile == nil {
+		return diag.Errorf("RRSet.profile missing: invalid SLBPool schema in: %#v", r)
+	}
+	var p slbPoolProfile
+	err = decodeRawProfile(r.Profile, slbPoolSchema, &p)
+	if err != nil {
+		return diag.Errorf("RRSet.profile could not be unmarshalled: %v\n", err)
+	}
+
+	// Set simple values
+	d.Set("description", p.Description)
+	d.Set("region_failure_sensitivity", p.RegionFailureSensitivity)
+	d.Set("serving_preference", p.ServingPreference)
+	d.Set("response_method", p.ResponseMethod)
+	d.Set("status", p.Status)
+
+	err = d.Set("monitor", zipPoolMonitor(p.Monitor))
+	if err != nil {
+		return diag.Errorf("monitor set failed: %v", err)
+	}
+	err = d.Set("all_fail_record", []map[string]interface{}{{
+		"rdata":       p.AllFailRecord.RData,
+		"description": p.AllFailRecord.Description,
+		"serving":     p.AllFailRecord.Serving,
+	}})
+	if err != nil {
+		return diag.Errorf("all_fail_record set failed: %v", err)
+	}
+	err = d.Set("rdata", makeSetFromSlbRdata(r.RData, p.RDataInfo))
+	if err != nil {
+		return diag.Errorf("rdata set failed: %v", err)
+	}
+	return nil
+}
+
+func resourceUltradnsSlbpoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := newRRSetResourceFromSlbpool(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] ultradns_slbpool update: %s", r.ID())
+	resp, err := client.RRSets.Update(r.RRSetKey(), r.RRSet())
+	if err != nil {
+		return diag.Errorf("resource update failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("resource update failed: %v", err)
+	}
+
+	return resourceUltradnsSlbpoolRead(ctx, d, meta)
+}
+
+func resourceUltradnsSlbpoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	r, err := newRRSetResourceFromSlbpool(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] ultradns_slbpool delete: %s", r.ID())
+	resp, err := client.RRSets.Delete(r.RRSetKey())
+	if err != nil {
+		return diag.Errorf("resource delete failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("resource delete failed: %v", err)
+	}
+
+	return nil
+}
+
+// State Function to seperate id into appropriate name and zone
+func resourceUltradnsSlbpoolImport(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return setResourceAndParseId(d)
+}
+
+// Resource Helpers
+
+func newRRSetResourceFromSlbpool(d *schema.ResourceData) (rRSetResource, error) {
+	rDataRaw := d.Get("rdata").(*schema.Set).List()
+	r := rRSetResource{
+		RRType:    "A",
+		Zone:      d.Get("zone").(string),
+		OwnerName: d.Get("name").(string),
+		TTL:       d.Get("ttl").(int),
+		RData:     unzipRdataHosts(rDataRaw),
+	}
+
+	profile := slbPoolProfile{
+		Context:                  slbPoolSchema,
+		Description:              d.Get("description").(string),
+		RegionFailureSensitivity: d.Get("region_failure_sensitivity").(string),
+		ServingPreference:        d.Get("serving_preference").(string),
+		ResponseMethod:           d.Get("response_method").(string),
+		Monitor:                  unzipPoolMonitor(d.Get("monitor").([]interface{})),
+		RDataInfo:                unzipSlbRdataInfos(rDataRaw),
+	}
+	if afr := d.Get("all_fail_record").([]interface{}); len(afr) == 1 && afr[0] != nil {
+		data := afr[0].(map[string]interface{})
+		profile.AllFailRecord = slbAllFailRecord{
+			RData:       data["rdata"].(string),
+			Description: data["description"].(string),
+		}
+	}
+
+	rp, err := rawProfile(profile)
+	if err != nil {
+		return r, fmt.Errorf("SLBPool profile could not be encoded: %v", err)
+	}
+	r.Profile = rp
+
+	return r, nil
+}
+
+func unzipSlbRdataInfos(configured []interface{}) []slbRDataInfo {
+	rdataInfos := make([]slbRDataInfo, 0, len(configured))
+	for _, rRaw := range configured {
+		data := rRaw.(map[string]interface{})
+		rdataInfos = append(rdataInfos, slbRDataInfo{
+			Description:    data["description"].(string),
+			ProbingEnabled: data["probing_enabled"].(bool),
+			ForcedState:    data["forced_state"].(string),
+		})
+	}
+	return rdataInfos
+}
+
+// makeSetFromSlbRdata encodes an array of Rdata into a
+// *schema.Set in the appropriate structure for the schema
+func makeSetFromSlbRdata(rds []string, rdis []slbRDataInfo) *schema.Set {
+	s := &schema.Set{F: hashRdatas}
+	for i, rdi := range rdis {
+		s.Add(map[string]interface{}{
+			"host":            rds[i],
+			"description":     rdi.Description,
+			"probing_enabled": rdi.ProbingEnabled,
+			"forced_state":    rdi.ForcedState,
+		})
+	}
+	return s
+}
+
+// schemaPoolMonitor returns the monitor block of the pools with a built-in
+// HTTP check
+func schemaPoolMonitor() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"url": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"method": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "GET",
+					ValidateFunc: validation.StringInSlice([]string{
+						"GET",
+						"POST",
+					}, false),
+				},
+				"transmitted_data": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"search_string": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// schemaRegionFailureSensitivity returns the region_failure_sensitivity
+// argument of the pools with a built-in HTTP check
+func schemaRegionFailureSensitivity() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "HIGH",
+		ValidateFunc: validation.StringInSlice([]string{
+			"HIGH",
+			"LOW",
+		}, false),
+	}
+}
+
+func unzipPoolMonitor(configured []interface{}) poolMonitor {
+	if len(configured) != 1 || configured[0] == nil {
+		return poolMonitor{}
+	}
+	data := configured[0].(map[string]interface{})
+	return poolMonitor{
+		URL:             data["url"].(string),
+		Method:          data["method"].(string),
+		TransmittedData: data["transmitted_data"].(string),
+		SearchString:    data["search_string"].(string),
+	}
+}
+
+func zipPoolMonitor(m poolMonitor) []map[string]interface{} {
+	return []map[string]interface{}{{
+		"url":              m.URL,
+		"method":           m.Method,
+		"transmitted_data": m.TransmittedData,
+		"search_string":    m.SearchString,
+	}}
+}
@@ -0,0 +1,102 @@
+package ultradns
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	udnssdk "github.com/ultradns/ultradns-sdk-go"
+)
+
+func TestDecodeRawProfileSlbpool(t *testing.T) {
+	rp := udnssdk.RawProfile{
+		"@context":                 string(slbPoolSchema),
+		"description":              "simple load balancing",
+		"regionFailureSensitivity": "LOW",
+		"servingPreference":        "SERVE_PRIMARY",
+		"responseMethod":           "PRIORITY_HUNT",
+		"status":                   "OK",
+		"monitor": map[string]interface{}{
+			"method":       "GET",
+			"url":          "http://www.example.com/health",
+			"searchString": "OK",
+		},
+		"allFailRecord": map[string]interface{}{
+			"rdata":   "10.0.0.9",
+			"serving": true,
+		},
+		"rdataInfo": []interface{}{map[string]interface{}{
+			"probingEnabled":   true,
+			"forcedState":      "NOT_FORCED",
+			"availableToServe": true,
+		}},
+	}
+
+	var p slbPoolProfile
+	assert.Nil(t, decodeRawProfile(rp, slbPoolSchema, &p), true)
+	assert.Equal(t, "LOW", p.RegionFailureSensitivity, true)
+	assert.Equal(t, "PRIORITY_HUNT", p.ResponseMethod, true)
+	assert.Equal(t, "OK", p.Status, true)
+	assert.Equal(t, poolMonitor{Method: "GET", URL: "http://www.example.com/health", SearchString: "OK"}, p.Monitor, true)
+	assert.Equal(t, slbAllFailRecord{RData: "10.0.0.9", Serving: true}, p.AllFailRecord, true)
+	assert.Equal(t, []slbRDataInfo{{ProbingEnabled: true, ForcedState: "NOT_FORCED", AvailableToServe: true}}, p.RDataInfo, true)
+
+	err := decodeRawProfile(rp, udnssdk.TCPoolSchema, &p)
+	assert.NotNil(t, err, true)
+	assert.Contains(t, err.Error(), "incorrect JSON-LD @context", true)
+}
+
+func TestResourceUltradnsSlbpoolLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	testResourceLifecycle(t, fake, resourceUltradnsSlbpool(),
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "slbpool",
+			"ttl":         300,
+			"description": "simple load balancing",
+			"rdata": []interface{}{
+				map[string]interface{}{"host": "10.0.0.1", "description": "first"},
+				map[string]interface{}{"host": "10.0.0.2"},
+			},
+			"monitor": []interface{}{
+				map[string]interface{}{"url": "http://www.example.com/health", "search_string": "OK"},
+			},
+			"all_fail_record": []interface{}{
+				map[string]interface{}{"rdata": "10.0.0.9"},
+			},
+		},
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "slbpool",
+			"ttl":         600,
+			"description": "simple load balancing, posted",
+			"rdata": []interface{}{
+				map[string]interface{}{"host": "10.0.0.1", "forced_state": "FORCED_ACTIVE"},
+				map[string]interface{}{"host": "10.0.0.3", "probing_enabled": false},
+			},
+			"monitor": []interface{}{
+				map[string]interface{}{
+					"url":              "http://www.example.com/health",
+					"method":           "POST",
+					"transmitted_data": "ping",
+					"search_string":    "pong",
+				},
+			},
+			"all_fail_record": []interface{}{
+				map[string]interface{}{"rdata": "10.0.0.8", "description": "sorry server"},
+			},
+			"region_failure_sensitivity": "LOW",
+			"serving_preference":         "SERVE_PRIMARY",
+			"response_method":            "PRIORITY_HUNT",
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			rrset, ok := fake.rrset("example.com", "A", "slbpool")
+			assert.True(t, ok, true)
+			assert.ElementsMatch(t, []string{"10.0.0.1", "10.0.0.3"}, rrset.RData, true)
+			assert.Equal(t, string(slbPoolSchema), rrset.Profile["@context"], true)
+			assert.Equal(t, 2, d.Get("rdata").(*schema.Set).Len(), true)
+			assert.Equal(t, "POST", d.Get("monitor.0.method"), true)
+			assert.Equal(t, "pong", d.Get("monitor.0.search_string"), true)
+			assert.Equal(t, "sorry server", d.Get("all_fail_record.0.description"), true)
+		})
+}
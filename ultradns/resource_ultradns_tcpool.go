@@ -1,30 +1,42 @@
 package ultradns
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strings"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ultradns/ultradns-sdk-go"
 )
 
 func resourceUltradnsTcpool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceUltradnsTcpoolCreate,
-		Read:   resourceUltradnsTcpoolRead,
-		Update: resourceUltradnsTcpoolUpdate,
-		Delete: resourceUltradnsTcpoolDelete,
+		CreateContext: resourceUltradnsTcpoolCreate,
+		ReadContext:   resourceUltradnsTcpoolRead,
+		UpdateContext: resourceUltradnsTcpoolUpdate,
+		DeleteContext: resourceUltradnsTcpoolDelete,
+
+		CustomizeDiff: customizeDiffProviderDefaults("zone", "ttl", "description"),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Importer: &schema.ResourceImporter{
-			State: resourceUltradnsTcpoolImport,
+			StateContext: resourceUltradnsTcpoolImport,
 		},
 
 		Schema: map[string]*schema.Schema{
 			// Required
 			"zone": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"name": {
@@ -34,7 +46,8 @@ func resourceUltradnsTcpool() *schema.Resource {
 			},
 			"description": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				// 0-255 char
 			},
 			"rdata": {
@@ -90,7 +103,7 @@ func resourceUltradnsTcpool() *schema.Resource {
 			"ttl": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				Default:  3600,
+				Computed: true,
 			},
 			"run_probes": {
 				Type:     schema.TypeBool,
@@ -129,32 +142,36 @@ func resourceUltradnsTcpool() *schema.Resource {
 
 // CRUD Operations
 
-func resourceUltradnsTcpoolCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltradnsTcpoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := newRRSetResourceFromTcpool(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	log.Printf("[INFO] ultradns_tcpool create: %#v", r)
-	_, err = client.RRSets.Create(r.RRSetKey(), r.RRSet())
+	log.Printf("[INFO] ultradns_tcpool create: %s", r.ID())
+	resp, err := client.RRSets.Create(r.RRSetKey(), r.RRSet())
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return fmt.Errorf("create failed: %#v -> %v", r, err)
+		return diag.Errorf("create failed: %v", err)
 	}
 
 	d.SetId(r.ID())
 	log.Printf("[INFO] ultradns_tcpool.id: %v", d.Id())
 
-	return resourceUltradnsTcpoolRead(d, meta)
+	return resourceUltradnsTcpoolRead(ctx, d, meta)
 }
 
-func resourceUltradnsTcpoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltradnsTcpoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	rr, err := newRRSetResourceFromTcpool(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	rrsets, err := client.RRSets.Select(rr.RRSetKey())
@@ -167,10 +184,10 @@ func resourceUltradnsTcpoolRead(d *schema.ResourceData, meta interface{}) error
 					d.SetId("")
 					return nil
 				}
-				return fmt.Errorf("resource not found: %v", err)
+				return diag.Errorf("resource not found: %v", err)
 			}
 		}
-		return fmt.Errorf("resource not found: %v", err)
+		return diag.Errorf("resource not found: %v", err)
 	}
 
 	r := rrsets[0]
@@ -191,11 +208,11 @@ func resourceUltradnsTcpoolRead(d *schema.ResourceData, meta interface{}) error
 
 	// And now... the Profile!
 	if r.Profile == nil {
-		return fmt.Errorf("RRSet.profile missing: invalid TCPool schema in: %#v", r)
+		return diag.Errorf("RRSet.profile missing: invalid TCPool schema in: %#v", r)
 	}
 	p, err := r.Profile.TCPoolProfile()
 	if err != nil {
-		return fmt.Errorf("RRSet.profile could not be unmarshalled: %v\n", err)
+		return diag.Errorf("RRSet.profile could not be unmarshalled: %v\n", err)
 	}
 
 	// Set simple values
@@ -211,40 +228,48 @@ func resourceUltradnsTcpoolRead(d *schema.ResourceData, meta interface{}) error
 	// TODO: rigorously test this to see if we can remove the error handling
 	err = d.Set("rdata", makeSetFromRdata(r.RData, p.RDataInfo))
 	if err != nil {
-		return fmt.Errorf("rdata set failed: %#v", err)
+		return diag.Errorf("rdata set failed: %#v", err)
 	}
 	return nil
 }
 
-func resourceUltradnsTcpoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltradnsTcpoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := newRRSetResourceFromTcpool(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	log.Printf("[INFO] ultradns_tcpool update: %+v", r)
-	_, err = client.RRSets.Update(r.RRSetKey(), r.RRSet())
+	log.Printf("[INFO] ultradns_tcpool update: %s", r.ID())
+	resp, err := client.RRSets.Update(r.RRSetKey(), r.RRSet())
+	if err != nil {
+		return diag.Errorf("resource update failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return fmt.Errorf("resource update failed: %v", err)
+		return diag.Errorf("resource update failed: %v", err)
 	}
 
-	return resourceUltradnsTcpoolRead(d, meta)
+	return resourceUltradnsTcpoolRead(ctx, d, meta)
 }
 
-func resourceUltradnsTcpoolDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*udnssdk.Client)
+func resourceUltradnsTcpoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
 
 	r, err := newRRSetResourceFromTcpool(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	log.Printf("[INFO] ultradns_tcpool delete: %+v", r)
-	_, err = client.RRSets.Delete(r.RRSetKey())
+	log.Printf("[INFO] ultradns_tcpool delete: %s", r.ID())
+	resp, err := client.RRSets.Delete(r.RRSetKey())
 	if err != nil {
-		return fmt.Errorf("resource delete failed: %v", err)
+		return diag.Errorf("resource delete failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("resource delete failed: %v", err)
 	}
 
 	return nil
@@ -298,7 +323,10 @@ func unzipRdataInfos(configured []interface{}) []udnssdk.SBRDataInfo {
 			RunProbes:     data["run_probes"].(bool),
 			State:         data["state"].(string),
 			Threshold:     data["threshold"].(int),
-			Weight:        data["weight"].(int),
+		}
+		// SiteBacker pools have no weights
+		if w, ok := data["weight"]; ok {
+			r.Weight = w.(int)
 		}
 		rdataInfos = append(rdataInfos, r)
 	}
@@ -316,7 +344,9 @@ func zipRData(rds []string, rdis []udnssdk.SBRDataInfo) []map[string]interface{}
 			"run_probes":     rdi.RunProbes,
 			"state":          rdi.State,
 			"threshold":      rdi.Threshold,
-			"weight":         rdi.Weight,
+		}
+		if rdi.Weight != nil {
+			r["weight"] = rdi.Weight
 		}
 		result = append(result, r)
 	}
@@ -337,6 +367,6 @@ func makeSetFromRdata(rds []string, rdis []udnssdk.SBRDataInfo) *schema.Set {
 //State importer function resourceUltradnsTcpoolImport
 // State Function to seperate id into appropriate name and zone
 func resourceUltradnsTcpoolImport(
-	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	return setResourceAndParseId(d)
 }
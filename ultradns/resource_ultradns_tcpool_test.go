@@ -1,14 +1,15 @@
 package ultradns
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	udnssdk "github.com/ultradns/ultradns-sdk-go"
@@ -77,7 +78,8 @@ func setResourceRecordTCPool() (resourceRecord *schema.Resource) {
 			// Required
 			"zone": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"name": {
@@ -87,7 +89,8 @@ func setResourceRecordTCPool() (resourceRecord *schema.Resource) {
 			},
 			"description": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				// 0-255 char
 			},
 			"rdata": {
@@ -143,7 +146,7 @@ func setResourceRecordTCPool() (resourceRecord *schema.Resource) {
 			"ttl": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				Default:  3600,
+				Computed: true,
 			},
 			"run_probes": {
 				Type:     schema.TypeBool,
@@ -270,9 +273,9 @@ func TestNewRRSetResourceFromTCPool(t *testing.T) {
 func TestResourceUltradnsTCPoolRead(t *testing.T) {
 	mocked := mockUltraDNSRecordTCPool{}
 
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 	resourceRecordObj := setResourceRecordTCPool()
 	resourceData := resourceRecordObj.TestResourceData()
 	expectedResourceRecordObj := setResourceRecordTCPool()
@@ -311,7 +314,7 @@ func TestResourceUltradnsTCPoolRead(t *testing.T) {
 	resourceData.Set("name", "test.provider.ultradns.net")
 	resourceData.Set("zone", "test.provider.ultradns.net")
 
-	resourceUltradnsTcpoolRead(resourceData, client)
+	resourceUltradnsTcpoolRead(context.Background(), resourceData, client)
 	compareTCPoolResources(t, expectedResourceData, resourceData)
 }
 
@@ -322,9 +325,9 @@ func TestResourceUltradnsTCPoolCreate(t *testing.T) {
 	actualData := resourceRecordObject.TestResourceData()
 	mocked := mockUltraDNSRecordTCPool{}
 
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 
 	rrsetDTO := make([]map[string]interface{}, 1)
 	data := []byte(`
@@ -369,7 +372,7 @@ func TestResourceUltradnsTCPoolCreate(t *testing.T) {
 	expectedData.Set("description", "testing")
 	expectedData.Set("hostname", "test.provider.ultradns.net.test.provider.ultradns.net")
 
-	resourceUltradnsTcpoolCreate(actualData, client)
+	resourceUltradnsTcpoolCreate(context.Background(), actualData, client)
 	compareTCPoolResources(t, expectedData, actualData)
 
 }
@@ -381,9 +384,9 @@ func TestResourceUltradnsTCPoolUpdate(t *testing.T) {
 	actualData := resourceRecordObject.TestResourceData()
 	mocked := mockUltraDNSRecordTCPool{}
 
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 
 	rrsetDTO := make([]map[string]interface{}, 1)
 	data := []byte(`
@@ -428,7 +431,7 @@ func TestResourceUltradnsTCPoolUpdate(t *testing.T) {
 	expectedData.Set("description", "testing")
 	expectedData.Set("hostname", "test.provider.ultradns.net.test.provider.ultradns.net")
 
-	resourceUltradnsTcpoolUpdate(actualData, client)
+	resourceUltradnsTcpoolUpdate(context.Background(), actualData, client)
 	compareTCPoolResources(t, expectedData, actualData)
 
 }
@@ -441,9 +444,9 @@ func TestResourceUltradnsTCPoolDelete(t *testing.T) {
 	actualData := resourceRecordObject.TestResourceData()
 	mocked := mockUltraDNSRecordTCPool{}
 
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 
 	rrsetDTO := make([]map[string]interface{}, 1)
 	data := []byte(`
@@ -489,20 +492,20 @@ func TestResourceUltradnsTCPoolDelete(t *testing.T) {
 	expectedData.Set("description", "testing")
 	expectedData.Set("hostname", "test.provider.ultradns.net.test.provider.ultradns.net")
 
-	resourceUltradnsTcpoolDelete(actualData, client)
+	resourceUltradnsTcpoolDelete(context.Background(), actualData, client)
 	compareTCPoolResources(t, expectedData, actualData)
 
 }
 
 func TestResourceUltradnsTCPoolImport(t *testing.T) {
 	mocked := mockUltraDNSRecordTCPool{}
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 	resourceRecordObj := setResourceRecordTCPool()
 	d := resourceRecordObj.TestResourceData()
 	d.SetId("test:test.provider.ultradns.net:A")
-	newRecordData, _ := resourceUltradnsTcpoolImport(d, client)
+	newRecordData, _ := resourceUltradnsTcpoolImport(context.Background(), d, client)
 	assert.Equal(t, newRecordData[0].Get("name"), "test", true)
 	assert.Equal(t, newRecordData[0].Get("zone"), "test.provider.ultradns.net", true)
 
@@ -511,18 +514,58 @@ func TestResourceUltradnsTCPoolImport(t *testing.T) {
 //Testcase to check fail case
 func TestResourceUltradnsTCPoolImportFailCase(t *testing.T) {
 	mocked := mockUltraDNSRecordTCPool{}
-	client := &udnssdk.Client{
+	client := &Client{Client: &udnssdk.Client{
 		RRSets: &mocked,
-	}
+	}}
 	resourceRecordObj := setResourceRecordTCPool()
 	d := resourceRecordObj.TestResourceData()
 	d.SetId("testabc.test.provider.ultradns.net")
-	_, err := resourceUltradnsTcpoolImport(d, client)
+	_, err := resourceUltradnsTcpoolImport(context.Background(), d, client)
 	log.Errorf("Error: %+v", err)
 	assert.NotNil(t, err, true)
 
 }
 
+func TestResourceUltradnsTCPoolLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	testResourceLifecycle(t, fake, resourceUltradnsTcpool(),
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "tcpool",
+			"ttl":         300,
+			"description": "traffic controller",
+			"rdata": []interface{}{
+				map[string]interface{}{"host": "10.0.0.1", "priority": 1, "weight": 2},
+				map[string]interface{}{"host": "10.0.0.2", "priority": 2, "weight": 2},
+			},
+			"run_probes":          true,
+			"act_on_probes":       true,
+			"max_to_lb":           2,
+			"backup_record_rdata": "10.0.0.9",
+		},
+		map[string]interface{}{
+			"zone":        "example.com",
+			"name":        "tcpool",
+			"ttl":         600,
+			"description": "traffic controller, reweighted",
+			"rdata": []interface{}{
+				map[string]interface{}{"host": "10.0.0.1", "priority": 1, "weight": 4},
+				map[string]interface{}{"host": "10.0.0.3", "priority": 2, "weight": 2, "state": "INACTIVE"},
+			},
+			"run_probes":          false,
+			"act_on_probes":       false,
+			"max_to_lb":           1,
+			"backup_record_rdata": "10.0.0.9",
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			rrset, ok := fake.rrset("example.com", "A", "tcpool")
+			assert.True(t, ok, true)
+			assert.ElementsMatch(t, []string{"10.0.0.1", "10.0.0.3"}, rrset.RData, true)
+			assert.Equal(t, string(udnssdk.TCPoolSchema), rrset.Profile["@context"], true)
+			assert.Equal(t, 2, d.Get("rdata").(*schema.Set).Len(), true)
+		})
+}
+
 func TestAccUltradnsTcpool(t *testing.T) {
 	var record udnssdk.RRSet
 	domain, _ := os.LookupEnv("ULTRADNS_DOMAIN")
@@ -0,0 +1,243 @@
+package ultradns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceUltradnsWebForward() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUltradnsWebForwardCreate,
+		ReadContext:   resourceUltradnsWebForwardRead,
+		UpdateContext: resourceUltradnsWebForwardUpdate,
+		DeleteContext: resourceUltradnsWebForwardDelete,
+
+		CustomizeDiff: customizeDiffProviderDefaults("zone"),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUltradnsWebForwardImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringIsNotWhiteSpace,
+					validation.StringDoesNotContainAny("/"),
+				),
+			},
+			"redirect_to": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			// Optional
+			"path": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressWebForwardPathSlash,
+			},
+			"forward_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "HTTP_301_REDIRECT",
+				ValidateFunc: validation.StringInSlice([]string{
+					"HTTP_301_REDIRECT",
+					"HTTP_302_REDIRECT",
+					"HTTP_303_REDIRECT",
+					"HTTP_307_REDIRECT",
+					"FRAMED",
+				}, false),
+			},
+			"query_string_forwarding": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"advanced": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// Computed
+			"guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// suppressWebForwardPathSlash ignores a leading slash in path, which the
+// API does not keep.
+func suppressWebForwardPathSlash(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimPrefix(old, "/") == strings.TrimPrefix(new, "/")
+}
+
+// CRUD Operations
+
+func resourceUltradnsWebForwardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	zone := d.Get("zone").(string)
+	wf := makeWebForwardDTO(d)
+	log.Printf("[INFO] ultradns_web_forward create: %s in %s", wf.RequestTo, zone)
+	guid, resp, err := client.createWebForward(zone, wf)
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	if guid == "" {
+		// Neither the response nor its Location named the new forward
+		guid, err = client.findWebForward(zone, wf.RequestTo)
+		if err != nil {
+			return diag.Errorf("create failed: %v", err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", zone, guid))
+	log.Printf("[INFO] ultradns_web_forward.id: %v", d.Id())
+
+	return resourceUltradnsWebForwardRead(ctx, d, meta)
+}
+
+func resourceUltradnsWebForwardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	zone, guid, err := parseZoneGUIDId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] ultradns_web_forward read: %s", d.Id())
+	wf, err := client.getWebForward(zone, guid)
+	if err != nil {
+		// 70002 means Data Not Found, 1801 that the zone is gone with it
+		if isNotFound(err) || isZoneNotFound(err) {
+			log.Printf("[WARN] ultradns_web_forward %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("not found: %v", err)
+	}
+
+	d.Set("zone", zone)
+	d.Set("guid", guid)
+	populateResourceDataFromWebForward(wf, d)
+	return nil
+}
+
+func resourceUltradnsWebForwardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	zone, guid, err := parseZoneGUIDId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	wf := makeWebForwardDTO(d)
+	wf.GUID = guid
+	log.Printf("[INFO] ultradns_web_forward update: %s", d.Id())
+	resp, err := client.updateWebForward(zone, guid, wf)
+	if err != nil {
+		return diag.Errorf("update failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("update failed: %v", err)
+	}
+
+	return resourceUltradnsWebForwardRead(ctx, d, meta)
+}
+
+func resourceUltradnsWebForwardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	zone, guid, err := parseZoneGUIDId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] ultradns_web_forward delete: %s", d.Id())
+	resp, err := client.deleteWebForward(zone, guid)
+	if err != nil {
+		if isNotFound(err) || isZoneNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("delete failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("delete failed: %v", err)
+	}
+
+	return nil
+}
+
+// State function to seperate id into zone and guid
+func resourceUltradnsWebForwardImport(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	zone, guid, err := parseZoneGUIDId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("zone", zone)
+	d.Set("guid", guid)
+	return []*schema.ResourceData{d}, nil
+}
+
+// Resource Helpers
+
+func makeWebForwardDTO(d *schema.ResourceData) webForwardDTO {
+	requestTo := d.Get("host").(string)
+	if p := strings.TrimPrefix(d.Get("path").(string), "/"); p != "" {
+		requestTo = requestTo + "/" + p
+	}
+	return webForwardDTO{
+		RequestTo:             requestTo,
+		DefaultRedirectTo:     d.Get("redirect_to").(string),
+		DefaultForwardType:    d.Get("forward_type").(string),
+		QueryStringForwarding: d.Get("query_string_forwarding").(bool),
+		Advanced:              d.Get("advanced").(bool),
+	}
+}
+
+func populateResourceDataFromWebForward(wf webForwardDTO, d *schema.ResourceData) {
+	host, path := wf.RequestTo, ""
+	if i := strings.Index(host, "/"); i >= 0 {
+		host, path = wf.RequestTo[:i], wf.RequestTo[i+1:]
+	}
+	d.Set("host", host)
+	// Keep the configured spelling of path, with or without leading slash
+	if strings.TrimPrefix(d.Get("path").(string), "/") != path {
+		d.Set("path", path)
+	}
+	d.Set("redirect_to", wf.DefaultRedirectTo)
+	d.Set("forward_type", wf.DefaultForwardType)
+	d.Set("query_string_forwarding", wf.QueryStringForwarding)
+	d.Set("advanced", wf.Advanced)
+}
@@ -0,0 +1,136 @@
+package ultradns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceUltradnsWebForwardImport(t *testing.T) {
+	d := resourceUltradnsWebForward().TestResourceData()
+	d.SetId("example.com:0608485259D5AC50")
+	imported, err := resourceUltradnsWebForwardImport(context.Background(), d, &Client{})
+	assert.Nil(t, err, true)
+	assert.Equal(t, "example.com", imported[0].Get("zone"), true)
+	assert.Equal(t, "0608485259D5AC50", imported[0].Get("guid"), true)
+
+	for _, id := range []string{"example.com", "www:example.com:0608485259D5AC50", ":0608485259D5AC50"} {
+		d.SetId(id)
+		_, err = resourceUltradnsWebForwardImport(context.Background(), d, &Client{})
+		assert.NotNil(t, err, id)
+	}
+}
+
+func TestMakeWebForwardDTO(t *testing.T) {
+	for path, requestTo := range map[string]string{
+		"":        "legacy.example.com",
+		"old":     "legacy.example.com/old",
+		"/old/*":  "legacy.example.com/old/*",
+		"/":       "legacy.example.com",
+		"a/b.htm": "legacy.example.com/a/b.htm",
+	} {
+		d := schema.TestResourceDataRaw(t, resourceUltradnsWebForward().Schema, map[string]interface{}{
+			"zone":        "example.com",
+			"host":        "legacy.example.com",
+			"path":        path,
+			"redirect_to": "https://www.example.com/",
+		})
+		wf := makeWebForwardDTO(d)
+		assert.Equal(t, requestTo, wf.RequestTo, path)
+		assert.Equal(t, "HTTP_301_REDIRECT", wf.DefaultForwardType, true)
+	}
+}
+
+func TestResourceUltradnsWebForwardLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	testResourceLifecycle(t, fake, resourceUltradnsWebForward(),
+		map[string]interface{}{
+			"zone":        "example.com",
+			"host":        "legacy.example.com",
+			"path":        "/old",
+			"redirect_to": "https://www.example.com/new",
+		},
+		map[string]interface{}{
+			"zone":                    "example.com",
+			"host":                    "legacy.example.com",
+			"path":                    "old/(.*)",
+			"redirect_to":             "https://www.example.com/new/$1",
+			"forward_type":            "HTTP_302_REDIRECT",
+			"query_string_forwarding": true,
+			"advanced":                true,
+		},
+		func(t *testing.T, d *schema.ResourceData) {
+			z := fake.zones[fakeZoneName("example.com")]
+			assert.Len(t, z.webForwards, 1, true)
+			wf := z.webForwards[d.Get("guid").(string)]
+			assert.Equal(t, "legacy.example.com/old/(.*)", wf.RequestTo, true)
+			assert.Equal(t, "HTTP_302_REDIRECT", wf.DefaultForwardType, true)
+			assert.True(t, wf.QueryStringForwarding, true)
+			assert.True(t, wf.Advanced, true)
+		})
+}
+
+func TestResourceUltradnsWebForwardAsync(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	fake.async = true
+	testResourceLifecycle(t, fake, resourceUltradnsWebForward(),
+		map[string]interface{}{
+			"zone":         "example.com",
+			"host":         "frame.example.com",
+			"redirect_to":  "https://www.example.com/",
+			"forward_type": "FRAMED",
+		},
+		nil, nil)
+}
+
+func TestResourceUltradnsWebForwardAsyncWithoutLocation(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	fake.async = true
+	fake.noLocation = true
+	testResourceLifecycle(t, fake, resourceUltradnsWebForward(),
+		map[string]interface{}{
+			"zone":        "example.com",
+			"host":        "legacy.example.com",
+			"redirect_to": "https://www.example.com/",
+		},
+		nil,
+		func(t *testing.T, d *schema.ResourceData) {
+			z := fake.zones[fakeZoneName("example.com")]
+			assert.Contains(t, z.webForwards, d.Get("guid").(string), true)
+		})
+}
+
+func TestResourceUltradnsWebForwardCreateWithoutGUID(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	fake.async = true
+	fake.noLocation = true
+	fake.noCreatedBody = true
+	testResourceLifecycle(t, fake, resourceUltradnsWebForward(),
+		map[string]interface{}{
+			"zone":        "example.com",
+			"host":        "legacy.example.com",
+			"path":        "old",
+			"redirect_to": "https://www.example.com/",
+		},
+		nil,
+		func(t *testing.T, d *schema.ResourceData) {
+			z := fake.zones[fakeZoneName("example.com")]
+			assert.Len(t, z.webForwards, 1, true)
+			assert.Equal(t, "legacy.example.com/old", z.webForwards[d.Get("guid").(string)].RequestTo, true)
+		})
+}
+
+func TestResourceUltradnsWebForwardZoneDeleted(t *testing.T) {
+	fake := newFakeUltraDNS(t)
+	res := resourceUltradnsWebForward()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"zone":        "example.com",
+		"host":        "legacy.example.com",
+		"redirect_to": "https://www.example.com/",
+	})
+	d.SetId("example.com:0608485259D5AC50")
+	assertNoDiagErrors(t, "read", res.ReadContext(context.Background(), d, fake.client(t)))
+	assert.Equal(t, "", d.Id(), true)
+}
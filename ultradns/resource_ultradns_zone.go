@@ -0,0 +1,298 @@
+package ultradns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// tsigAlgorithms lists the TSIG algorithms UltraDNS supports
+var tsigAlgorithms = []string{
+	"hmac-md5",
+	"hmac-sha1",
+	"hmac-sha224",
+	"hmac-sha256",
+	"hmac-sha384",
+	"hmac-sha512",
+}
+
+func resourceUltradnsZone() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUltradnsZoneCreate,
+		ReadContext:   resourceUltradnsZoneRead,
+		DeleteContext: resourceUltradnsZoneDelete,
+
+		CustomizeDiff: customizeDiffZoneCreateType,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUltradnsZoneImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Optional
+			"account_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressAccountNameCase,
+			},
+			// create_type, original_zone_name and transfer_name_server
+			// only matter while the zone is created
+			"create_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "NEW",
+				ForceNew:         true,
+				DiffSuppressFunc: suppressAfterCreate,
+				ValidateFunc: validation.StringInSlice([]string{
+					"NEW",
+					"COPY",
+					"TRANSFER",
+				}, false),
+			},
+			"original_zone_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressAfterCreate,
+			},
+			"transfer_name_server": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				ForceNew:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressAfterCreate,
+				Elem: &schema.Resource{
+					Schema: schemaNameServer(suppressAfterCreate),
+				},
+			},
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nameservers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// schemaNameServer returns the attributes of a nameserver zone data is
+// transferred from. suppress, when set, is used for all of them.
+func schemaNameServer(suppress schema.SchemaDiffSuppressFunc) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ip": {
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppress,
+		},
+		"tsig_key_name": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: suppress,
+		},
+		"tsig_key_value": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppress,
+		},
+		"tsig_algorithm": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: suppress,
+			ValidateFunc:     validation.StringInSlice(tsigAlgorithms, false),
+		},
+	}
+}
+
+// suppressAfterCreate hides changes to arguments that are only used to
+// create a resource, so that they neither replace nor update it later.
+func suppressAfterCreate(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+// customizeDiffZoneCreateType checks that the arguments for the chosen
+// create_type, and only those, are set.
+func customizeDiffZoneCreateType(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	createType := d.Get("create_type").(string)
+	_, hasOriginal := d.GetOk("original_zone_name")
+	_, hasNameServer := d.GetOk("transfer_name_server")
+	switch {
+	case createType == "COPY" && !hasOriginal:
+		return fmt.Errorf("original_zone_name must be set when create_type is COPY")
+	case createType != "COPY" && hasOriginal:
+		return fmt.Errorf("original_zone_name can only be set when create_type is COPY")
+	case createType == "TRANSFER" && !hasNameServer:
+		return fmt.Errorf("transfer_name_server must be set when create_type is TRANSFER")
+	case createType != "TRANSFER" && hasNameServer:
+		return fmt.Errorf("transfer_name_server can only be set when create_type is TRANSFER")
+	}
+	return nil
+}
+
+// CRUD Operations
+
+func resourceUltradnsZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	account, err := client.account(d.Get("account_name").(string))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	z := makeZoneDTO(d, account)
+	log.Printf("[INFO] ultradns_zone create: %s (%s)", z.Properties.Name, z.PrimaryCreateInfo.CreateType)
+	resp, err := client.createZone(z)
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("create failed: %v", err)
+	}
+
+	d.SetId(z.Properties.Name)
+	log.Printf("[INFO] ultradns_zone.id: %v", d.Id())
+
+	return resourceUltradnsZoneRead(ctx, d, meta)
+}
+
+func resourceUltradnsZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	z, err := client.getZone(d.Id())
+	if err != nil {
+		if isZoneNotFound(err) {
+			log.Printf("[WARN] ultradns_zone %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("not found: %v", err)
+	}
+	if z.Properties.Type != "PRIMARY" {
+		return diag.Errorf("zone %s is a %s zone, not a primary zone", d.Id(), z.Properties.Type)
+	}
+
+	nameservers, err := client.zoneNameservers(d.Id())
+	if err != nil {
+		return diag.Errorf("nameservers not found: %v", err)
+	}
+
+	if err := populateResourceDataFromZone(z, d); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("nameservers", nameservers); err != nil {
+		return diag.Errorf("nameservers set failed: %v", err)
+	}
+	return nil
+}
+
+func resourceUltradnsZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	log.Printf("[INFO] ultradns_zone delete: %s", d.Id())
+	resp, err := client.deleteZone(d.Id())
+	if err != nil {
+		if isZoneNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("delete failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("delete failed: %v", err)
+	}
+
+	return nil
+}
+
+// State function to take the zone name from the ID
+func resourceUltradnsZoneImport(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return setZoneResourceAndParseId(d)
+}
+
+// Resource Helpers
+
+// makeZoneDTO converts ResourceData into the request creating a primary
+// zone in account.
+func makeZoneDTO(d *schema.ResourceData, account string) zoneDTO {
+	info := &primaryZoneCreateInfo{
+		CreateType: d.Get("create_type").(string),
+	}
+	switch info.CreateType {
+	case "COPY":
+		info.OriginalZoneName = d.Get("original_zone_name").(string)
+	case "TRANSFER":
+		if ns := d.Get("transfer_name_server").([]interface{}); len(ns) == 1 {
+			info.NameServer = makeNameServerDTO(ns[0])
+		}
+	}
+
+	return zoneDTO{
+		Properties: zoneProperties{
+			Name:        d.Get("name").(string),
+			AccountName: account,
+			Type:        "PRIMARY",
+		},
+		PrimaryCreateInfo: info,
+	}
+}
+
+// makeNameServerDTO converts a map[string]interface{} from a nameserver
+// block into a nameServerDTO
+func makeNameServerDTO(configured interface{}) *nameServerDTO {
+	data := configured.(map[string]interface{})
+	return &nameServerDTO{
+		IP:            data["ip"].(string),
+		TSIGKey:       data["tsig_key_name"].(string),
+		TSIGKeyValue:  data["tsig_key_value"].(string),
+		TSIGAlgorithm: data["tsig_algorithm"].(string),
+	}
+}
+
+// populateResourceDataFromZone takes a zone and populates the ResourceData
+func populateResourceDataFromZone(z zoneDTO, d *schema.ResourceData) error {
+	// The API answers with the canonical name; keep the configured spelling
+	if d.Get("name").(string) == "" {
+		d.Set("name", z.Properties.Name)
+	}
+	d.Set("account_name", z.Properties.AccountName)
+	d.Set("status", z.Properties.Status)
+	d.Set("resource_record_count", z.Properties.ResourceRecordCount)
+	d.Set("last_modified", z.Properties.LastModifiedDateTime)
+	return nil
+}
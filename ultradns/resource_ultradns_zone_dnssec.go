@@ -0,0 +1,270 @@
+package ultradns
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceUltradnsZoneDNSSEC() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUltradnsZoneDNSSECCreate,
+		ReadContext:   resourceUltradnsZoneDNSSECRead,
+		UpdateContext: resourceUltradnsZoneDNSSECUpdate,
+		DeleteContext: resourceUltradnsZoneDNSSECDelete,
+
+		CustomizeDiff: customizeDiffZoneDNSSECKeys,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUltradnsZoneDNSSECImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressZoneNameCase,
+			},
+			// Optional
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"nsec_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "NSEC3",
+				ValidateFunc: validation.StringInSlice([]string{
+					"NSEC",
+					"NSEC3",
+				}, false),
+			},
+			"rollover_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rollover_key_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ZSK",
+				ValidateFunc: validation.StringInSlice([]string{
+					"KSK",
+					"ZSK",
+					"ALL",
+				}, false),
+			},
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ds_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"dnskey_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// customizeDiffZoneDNSSECKeys marks the records of the keys unknown when
+// the apply replaces the keys, so that consumers of the DS records, e.g. a
+// registrar, plan with the new ones rather than the old.
+func customizeDiffZoneDNSSECKeys(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	keyType := d.Get("rollover_key_type").(string)
+	rollover := d.HasChange("rollover_trigger") && d.Get("enabled").(bool)
+	var computed []string
+	switch {
+	case d.HasChange("enabled"):
+		computed = []string{"status", "ds_records", "dnskey_records"}
+	case rollover && (keyType == "KSK" || keyType == "ALL"):
+		computed = []string{"status", "ds_records", "dnskey_records"}
+	case rollover:
+		// A new zone signing key has no DS record
+		computed = []string{"status", "dnskey_records"}
+	}
+	for _, k := range computed {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CRUD Operations
+
+func resourceUltradnsZoneDNSSECCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	zone := d.Get("zone").(string)
+	if d.Get("enabled").(bool) {
+		log.Printf("[INFO] ultradns_zone_dnssec sign: %s with %s", zone, d.Get("nsec_type"))
+		resp, err := client.signZone(zone, d.Get("nsec_type").(string))
+		if err != nil {
+			return diag.Errorf("create failed: %v", err)
+		}
+		err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.Errorf("create failed: %v", err)
+		}
+	}
+
+	d.SetId(zone)
+	log.Printf("[INFO] ultradns_zone_dnssec.id: %v", d.Id())
+
+	return resourceUltradnsZoneDNSSECRead(ctx, d, meta)
+}
+
+func resourceUltradnsZoneDNSSECRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	log.Printf("[DEBUG] ultradns_zone_dnssec read: %s", d.Id())
+	s, err := client.getZoneDNSSEC(d.Id())
+	if err != nil {
+		if isZoneNotFound(err) {
+			log.Printf("[WARN] ultradns_zone_dnssec %s: zone not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("not found: %v", err)
+	}
+
+	populateResourceDataFromZoneDNSSEC(s, d)
+	return nil
+}
+
+func resourceUltradnsZoneDNSSECUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	zone := d.Id()
+	enabled := d.Get("enabled").(bool)
+	nsecType := d.Get("nsec_type").(string)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	switch {
+	case d.HasChange("enabled") && enabled:
+		log.Printf("[INFO] ultradns_zone_dnssec sign: %s with %s", zone, nsecType)
+		resp, err := client.signZone(zone, nsecType)
+		if err != nil {
+			return diag.Errorf("update failed: %v", err)
+		}
+		if err := waitForTask(ctx, client.Client, resp, timeout); err != nil {
+			return diag.Errorf("update failed: %v", err)
+		}
+	case d.HasChange("enabled"):
+		log.Printf("[INFO] ultradns_zone_dnssec unsign: %s", zone)
+		resp, err := client.unsignZone(zone)
+		if err != nil {
+			return diag.Errorf("update failed: %v", err)
+		}
+		if err := waitForTask(ctx, client.Client, resp, timeout); err != nil {
+			return diag.Errorf("update failed: %v", err)
+		}
+		return resourceUltradnsZoneDNSSECRead(ctx, d, meta)
+	case !enabled:
+		// Nothing to change in an unsigned zone; nsec_type and the
+		// rollover settings apply when it is signed again.
+		return resourceUltradnsZoneDNSSECRead(ctx, d, meta)
+	case d.HasChange("nsec_type"):
+		// Only for a zone that stays signed, signing uses nsec_type itself
+		log.Printf("[INFO] ultradns_zone_dnssec update: %s to %s", zone, nsecType)
+		resp, err := client.updateZoneDNSSEC(zone, nsecType)
+		if err != nil {
+			return diag.Errorf("update failed: %v", err)
+		}
+		if err := waitForTask(ctx, client.Client, resp, timeout); err != nil {
+			return diag.Errorf("update failed: %v", err)
+		}
+	}
+
+	if d.HasChange("rollover_trigger") {
+		keyType := d.Get("rollover_key_type").(string)
+		log.Printf("[INFO] ultradns_zone_dnssec rollover: %s %s", zone, keyType)
+		resp, err := client.rolloverZoneKeys(zone, keyType)
+		if err != nil {
+			return diag.Errorf("key rollover failed: %v", err)
+		}
+		if err := waitForTask(ctx, client.Client, resp, timeout); err != nil {
+			return diag.Errorf("key rollover failed: %v", err)
+		}
+	}
+
+	return resourceUltradnsZoneDNSSECRead(ctx, d, meta)
+}
+
+func resourceUltradnsZoneDNSSECDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).withContext(ctx)
+
+	if d.Get("status").(string) == "UNSIGNED" {
+		return nil
+	}
+
+	log.Printf("[INFO] ultradns_zone_dnssec unsign: %s", d.Id())
+	resp, err := client.unsignZone(d.Id())
+	if err != nil {
+		if isZoneNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("delete failed: %v", err)
+	}
+	err = waitForTask(ctx, client.Client, resp, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("delete failed: %v", err)
+	}
+
+	return nil
+}
+
+// State function to take the zone name from the ID
+func resourceUltradnsZoneDNSSECImport(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return setZoneAttributeAndParseId(d, "zone")
+}
+
+// Resource Helpers
+
+// populateResourceDataFromZoneDNSSEC takes the DNSSEC configuration of a
+// zone and populates the ResourceData
+func populateResourceDataFromZoneDNSSEC(s zoneDNSSECDTO, d *schema.ResourceData) {
+	signed := s.Status == "SIGNED"
+	d.Set("enabled", signed)
+	d.Set("status", s.Status)
+	// An unsigned zone keeps the configured nsec_type for when it is
+	// signed again
+	if signed && s.NSECType != "" {
+		d.Set("nsec_type", s.NSECType)
+	}
+
+	ds := []string{}
+	dnskeys := []string{}
+	for _, k := range s.Keys {
+		dnskeys = append(dnskeys, k.DNSKEYRecord)
+		if k.Type == "KSK" {
+			ds = append(ds, k.DSRecords...)
+		}
+	}
+	d.Set("ds_records", ds)
+	d.Set("dnskey_records", dnskeys)
+}
@@ -0,0 +1,263 @@
+package ultradns
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// applyZoneDNSSEC plans and applies raw against state, as terraform apply
+// would, so that updates only see the attributes that changed.
+func applyZoneDNSSEC(t *testing.T, client *Client, state *terraform.InstanceState, raw map[string]interface{}) *terraform.InstanceState {
+	t.Helper()
+	ctx := context.Background()
+	res := resourceUltradnsZoneDNSSEC()
+	diff, err := res.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), client)
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	if diff == nil {
+		return state
+	}
+	state, diags := res.Apply(ctx, state, diff, client)
+	assertNoDiagErrors(t, "apply", diags)
+	return state
+}
+
+// planZoneDNSSEC plans raw against state and returns the attributes the
+// plan leaves unknown.
+func planZoneDNSSEC(t *testing.T, client *Client, state *terraform.InstanceState, raw map[string]interface{}) []string {
+	t.Helper()
+	diff, err := resourceUltradnsZoneDNSSEC().Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), client)
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	computed := []string{}
+	if diff == nil {
+		return computed
+	}
+	for k, a := range diff.Attributes {
+		if a.NewComputed {
+			computed = append(computed, k)
+		}
+	}
+	sort.Strings(computed)
+	return computed
+}
+
+func TestResourceUltradnsZoneDNSSECImport(t *testing.T) {
+	d := resourceUltradnsZoneDNSSEC().TestResourceData()
+	d.SetId("example.com.")
+	imported, err := resourceUltradnsZoneDNSSECImport(context.Background(), d, &Client{})
+	assert.Nil(t, err, true)
+	assert.Equal(t, "example.com.", imported[0].Get("zone"), true)
+
+	d.SetId("www:example.com")
+	_, err = resourceUltradnsZoneDNSSECImport(context.Background(), d, &Client{})
+	assert.NotNil(t, err, true)
+}
+
+func TestResourceUltradnsZoneDNSSECLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	client := fake.client(t)
+	z := fake.zones[fakeZoneName("example.com")]
+
+	// Sign
+	state := applyZoneDNSSEC(t, client, nil, map[string]interface{}{
+		"zone": "example.com",
+	})
+	assert.Equal(t, "example.com", state.ID, true)
+	assert.Equal(t, "SIGNED", state.Attributes["status"], true)
+	assert.Equal(t, "NSEC3", z.dnssec.NSECType, true)
+	assert.Equal(t, "1", state.Attributes["ds_records.#"], true)
+	assert.Equal(t, "2", state.Attributes["dnskey_records.#"], true)
+	assert.Equal(t, z.dnssec.Keys[0].DSRecords[0], state.Attributes["ds_records.0"], true)
+	ds := state.Attributes["ds_records.0"]
+	zsk := z.dnssec.Keys[1].DNSKEYRecord
+
+	// Switching to NSEC keeps the keys
+	state = applyZoneDNSSEC(t, client, state, map[string]interface{}{
+		"zone":      "example.com",
+		"nsec_type": "NSEC",
+	})
+	assert.Equal(t, "NSEC", z.dnssec.NSECType, true)
+	assert.Equal(t, ds, state.Attributes["ds_records.0"], true)
+
+	// Changing only the key type does not roll over
+	state = applyZoneDNSSEC(t, client, state, map[string]interface{}{
+		"zone":              "example.com",
+		"nsec_type":         "NSEC",
+		"rollover_key_type": "KSK",
+	})
+	assert.Equal(t, ds, state.Attributes["ds_records.0"], true)
+
+	// A new trigger rolls the key signing key over, with a new DS record
+	state = applyZoneDNSSEC(t, client, state, map[string]interface{}{
+		"zone":              "example.com",
+		"nsec_type":         "NSEC",
+		"rollover_key_type": "KSK",
+		"rollover_trigger":  "2026-10",
+	})
+	assert.NotEqual(t, ds, state.Attributes["ds_records.0"], true)
+	assert.Equal(t, z.dnssec.Keys[0].DSRecords[0], state.Attributes["ds_records.0"], true)
+	assert.Equal(t, zsk, z.dnssec.Keys[1].DNSKEYRecord, true)
+
+	// Disable and enable signing
+	state = applyZoneDNSSEC(t, client, state, map[string]interface{}{
+		"zone":             "example.com",
+		"enabled":          false,
+		"rollover_trigger": "2026-10",
+	})
+	assert.Nil(t, z.dnssec, true)
+	assert.Equal(t, "UNSIGNED", state.Attributes["status"], true)
+	assert.Equal(t, "0", state.Attributes["ds_records.#"], true)
+
+	state = applyZoneDNSSEC(t, client, state, map[string]interface{}{
+		"zone":             "example.com",
+		"rollover_trigger": "2026-11",
+	})
+	assert.NotNil(t, z.dnssec, true)
+	assert.Equal(t, "SIGNED", state.Attributes["status"], true)
+	assert.Equal(t, "NSEC3", z.dnssec.NSECType, true)
+
+	// Import
+	res := resourceUltradnsZoneDNSSEC()
+	d := res.TestResourceData()
+	d.SetId("example.com")
+	imported, err := res.Importer.StateContext(context.Background(), d, client)
+	assert.Nil(t, err, true)
+	assertNoDiagErrors(t, "read after import", res.ReadContext(context.Background(), imported[0], client))
+	assert.Equal(t, true, imported[0].Get("enabled"), true)
+	assert.Equal(t, "NSEC3", imported[0].Get("nsec_type"), true)
+	assert.Len(t, imported[0].Get("ds_records").([]interface{}), 1, true)
+
+	// Destroy unsigns the zone
+	diags := res.DeleteContext(context.Background(), imported[0], client)
+	assertNoDiagErrors(t, "delete", diags)
+	assert.Nil(t, z.dnssec, true)
+	assert.Equal(t, "UNSIGNED", z.zone.Properties.DNSSECStatus, true)
+}
+
+func TestResourceUltradnsZoneDNSSECPlanKeys(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	client := fake.client(t)
+
+	state := applyZoneDNSSEC(t, client, nil, map[string]interface{}{
+		"zone":              "example.com",
+		"rollover_key_type": "KSK",
+		"rollover_trigger":  "1",
+	})
+
+	// A new key signing key brings new DS records
+	for _, keyType := range []string{"KSK", "ALL"} {
+		computed := planZoneDNSSEC(t, client, state, map[string]interface{}{
+			"zone":              "example.com",
+			"rollover_key_type": keyType,
+			"rollover_trigger":  "2",
+		})
+		assert.Equal(t, []string{"dnskey_records.#", "ds_records.#", "status"}, computed, true)
+	}
+
+	// A new zone signing key does not
+	computed := planZoneDNSSEC(t, client, state, map[string]interface{}{
+		"zone":              "example.com",
+		"rollover_key_type": "ZSK",
+		"rollover_trigger":  "2",
+	})
+	assert.Equal(t, []string{"dnskey_records.#", "status"}, computed, true)
+
+	// Nor do other changes of a signed zone
+	computed = planZoneDNSSEC(t, client, state, map[string]interface{}{
+		"zone":              "example.com",
+		"nsec_type":         "NSEC",
+		"rollover_key_type": "KSK",
+		"rollover_trigger":  "1",
+	})
+	assert.Equal(t, []string{}, computed, true)
+
+	computed = planZoneDNSSEC(t, client, state, map[string]interface{}{
+		"zone":              "example.com",
+		"enabled":           false,
+		"rollover_key_type": "KSK",
+		"rollover_trigger":  "1",
+	})
+	assert.Equal(t, []string{"dnskey_records.#", "ds_records.#", "status"}, computed, true)
+
+	// Signing again replaces all keys
+	state = applyZoneDNSSEC(t, client, state, map[string]interface{}{
+		"zone":              "example.com",
+		"enabled":           false,
+		"rollover_key_type": "KSK",
+		"rollover_trigger":  "1",
+	})
+	computed = planZoneDNSSEC(t, client, state, map[string]interface{}{
+		"zone":              "example.com",
+		"rollover_key_type": "KSK",
+		"rollover_trigger":  "1",
+	})
+	assert.Equal(t, []string{"dnskey_records.#", "ds_records.#", "status"}, computed, true)
+
+	// A rollover of an unsigned zone waits for it to be signed
+	computed = planZoneDNSSEC(t, client, state, map[string]interface{}{
+		"zone":              "example.com",
+		"enabled":           false,
+		"rollover_key_type": "KSK",
+		"rollover_trigger":  "2",
+	})
+	assert.Equal(t, []string{}, computed, true)
+}
+
+func TestResourceUltradnsZoneDNSSECSignAndRollover(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	client := fake.client(t)
+	z := fake.zones[fakeZoneName("example.com")]
+
+	state := applyZoneDNSSEC(t, client, nil, map[string]interface{}{
+		"zone":    "example.com",
+		"enabled": false,
+	})
+
+	// Signing, a new nsec_type and a rollover in one apply
+	state = applyZoneDNSSEC(t, client, state, map[string]interface{}{
+		"zone":              "example.com",
+		"nsec_type":         "NSEC",
+		"rollover_key_type": "KSK",
+		"rollover_trigger":  "1",
+	})
+	assert.Equal(t, "SIGNED", state.Attributes["status"], true)
+	assert.Equal(t, "NSEC", z.dnssec.NSECType, true)
+	// the key signing key was replaced after both keys were created
+	assert.True(t, z.dnssec.Keys[0].KeyTag > z.dnssec.Keys[1].KeyTag, true)
+	assert.Equal(t, z.dnssec.Keys[0].DSRecords[0], state.Attributes["ds_records.0"], true)
+}
+
+func TestResourceUltradnsZoneDNSSECDisabled(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	client := fake.client(t)
+
+	state := applyZoneDNSSEC(t, client, nil, map[string]interface{}{
+		"zone":    "example.com",
+		"enabled": false,
+	})
+	assert.Equal(t, "UNSIGNED", state.Attributes["status"], true)
+	assert.Nil(t, fake.zones[fakeZoneName("example.com")].dnssec, true)
+
+	res := resourceUltradnsZoneDNSSEC()
+	d := res.TestResourceData()
+	d.SetId(state.ID)
+	d.Set("status", "UNSIGNED")
+	assertNoDiagErrors(t, "delete", res.DeleteContext(context.Background(), d, client))
+}
+
+func TestResourceUltradnsZoneDNSSECZoneDeleted(t *testing.T) {
+	fake := newFakeUltraDNS(t)
+	res := resourceUltradnsZoneDNSSEC()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"zone": "example.com"})
+	d.SetId("example.com")
+	assertNoDiagErrors(t, "read", res.ReadContext(context.Background(), d, fake.client(t)))
+	assert.Equal(t, "", d.Id(), true)
+}
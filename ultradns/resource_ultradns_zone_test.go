@@ -0,0 +1,135 @@
+package ultradns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	udnssdk "github.com/ultradns/ultradns-sdk-go"
+)
+
+func TestResourceUltradnsZoneLifecycle(t *testing.T) {
+	fake := newFakeUltraDNS(t)
+	testResourceLifecycle(t, fake, resourceUltradnsZone(),
+		map[string]interface{}{
+			"name": "example.com.",
+		},
+		nil,
+		func(t *testing.T, d *schema.ResourceData) {
+			assert.Equal(t, fakeAccount, d.Get("account_name"), true)
+			assert.Equal(t, "ACTIVE", d.Get("status"), true)
+			assert.Equal(t, 3, d.Get("resource_record_count"), true)
+			assert.NotEmpty(t, d.Get("last_modified"), true)
+			assert.Equal(t, []interface{}{"udns1.ultradns.net.", "udns2.ultradns.net."}, d.Get("nameservers"), true)
+		})
+}
+
+func TestResourceUltradnsZoneAccountNameCase(t *testing.T) {
+	fake := newFakeUltraDNS(t)
+	client := fake.client(t)
+	res := resourceUltradnsZone()
+
+	raw := map[string]interface{}{
+		"name":         "example.com.",
+		"account_name": "Terraform-Account",
+	}
+	d := schema.TestResourceDataRaw(t, res.Schema, raw)
+	assertNoDiagErrors(t, "create", res.CreateContext(context.Background(), d, client))
+	assert.Equal(t, fakeAccount, d.Get("account_name"), true)
+
+	// The API's spelling in state neither changes nor replaces the zone
+	diff, err := res.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(raw), client)
+	assert.Nil(t, err, true)
+	if diff != nil {
+		_, ok := diff.Attributes["account_name"]
+		assert.False(t, ok, true)
+		assert.False(t, diff.RequiresNew(), true)
+	}
+}
+
+func TestResourceUltradnsZoneCopy(t *testing.T) {
+	fake := newFakeUltraDNS(t, "original.com")
+	fake.addRRSet("original.com", udnssdk.RRSet{OwnerName: "www", RRType: "A", TTL: 300, RData: []string{"10.0.0.1"}})
+	client := fake.client(t)
+
+	d := schema.TestResourceDataRaw(t, resourceUltradnsZone().Schema, map[string]interface{}{
+		"name":               "copy.com.",
+		"create_type":        "COPY",
+		"original_zone_name": "original.com.",
+	})
+	assertNoDiagErrors(t, "create", resourceUltradnsZoneCreate(context.Background(), d, client))
+	assert.Equal(t, "copy.com.", d.Id(), true)
+	assert.Equal(t, 4, d.Get("resource_record_count"), true)
+
+	rrset, ok := fake.rrset("copy.com", "A", "www")
+	assert.True(t, ok, true)
+	assert.Equal(t, []string{"10.0.0.1"}, rrset.RData, true)
+
+	d = schema.TestResourceDataRaw(t, resourceUltradnsZone().Schema, map[string]interface{}{
+		"name":               "other.com.",
+		"create_type":        "COPY",
+		"original_zone_name": "missing.com.",
+	})
+	diags := resourceUltradnsZoneCreate(context.Background(), d, client)
+	assert.True(t, diags.HasError(), true)
+	assert.Contains(t, diags[0].Summary, "Zone does not exist", true)
+}
+
+func TestResourceUltradnsZoneTransfer(t *testing.T) {
+	fake := newFakeUltraDNS(t)
+	client := fake.client(t)
+
+	d := schema.TestResourceDataRaw(t, resourceUltradnsZone().Schema, map[string]interface{}{
+		"name":        "transferred.com.",
+		"create_type": "TRANSFER",
+		"transfer_name_server": []interface{}{
+			map[string]interface{}{
+				"ip":             "192.0.2.53",
+				"tsig_key_name":  "transfer-key.",
+				"tsig_key_value": "c2VjcmV0",
+				"tsig_algorithm": "hmac-sha256",
+			},
+		},
+	})
+	assertNoDiagErrors(t, "create", resourceUltradnsZoneCreate(context.Background(), d, client))
+	assert.Equal(t, "transferred.com.", d.Id(), true)
+	assert.Equal(t, "ACTIVE", d.Get("status"), true)
+}
+
+func TestResourceUltradnsZoneReadNotPrimary(t *testing.T) {
+	fake := newFakeUltraDNS(t, "example.com")
+	fake.zones["example.com."].zone.Properties.Type = "SECONDARY"
+
+	d := resourceUltradnsZone().TestResourceData()
+	d.SetId("example.com.")
+	diags := resourceUltradnsZoneRead(context.Background(), d, fake.client(t))
+	assert.True(t, diags.HasError(), true)
+	assert.Contains(t, diags[0].Summary, "not a primary zone", true)
+}
+
+func TestCustomizeDiffZoneCreateType(t *testing.T) {
+	cases := []struct {
+		raw map[string]interface{}
+		err string
+	}{
+		{map[string]interface{}{"name": "example.com."}, ""},
+		{map[string]interface{}{"name": "example.com.", "create_type": "COPY"}, "original_zone_name must be set"},
+		{map[string]interface{}{"name": "example.com.", "original_zone_name": "other.com."}, "original_zone_name can only be set"},
+		{map[string]interface{}{"name": "example.com.", "create_type": "TRANSFER"}, "transfer_name_server must be set"},
+		{map[string]interface{}{
+			"name":                 "example.com.",
+			"transfer_name_server": []interface{}{map[string]interface{}{"ip": "192.0.2.53"}},
+		}, "transfer_name_server can only be set"},
+	}
+	for _, c := range cases {
+		_, err := resourceUltradnsZone().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(c.raw), &Client{})
+		if c.err == "" {
+			assert.Nil(t, err, true)
+		} else {
+			assert.NotNil(t, err, true)
+			assert.Contains(t, err.Error(), c.err, true)
+		}
+	}
+}
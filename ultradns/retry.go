@@ -0,0 +1,132 @@
+package ultradns
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// retryTransport replays requests that failed for transient reasons: rate
+// limiting, server errors and broken connections. Waits grow exponentially
+// from minWait up to maxWait unless the API asks for a specific delay with
+// Retry-After.
+//
+// Requests that are not idempotent (POST) are only replayed when the API
+// cannot have acted on them, so a create is never submitted twice.
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	minWait    time.Duration
+	maxWait    time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(r)
+		if attempt >= t.maxRetries || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if err != nil {
+			log.Printf("[DEBUG] %s %s failed: %s, retrying in %s (%d/%d)", req.Method, req.URL.Path, err, wait, attempt+1, t.maxRetries)
+		} else {
+			log.Printf("[DEBUG] %s %s returned %d, retrying in %s (%d/%d)", req.Method, req.URL.Path, resp.StatusCode, wait, attempt+1, t.maxRetries)
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry decides whether a request may be replayed given the outcome
+// of its last attempt.
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Body != nil && req.GetBody == nil {
+		// the body has been consumed and cannot be sent again
+		return false
+	}
+	if req.Context().Err() != nil {
+		return false
+	}
+	idempotent := req.Method != http.MethodPost && req.Method != http.MethodPatch
+
+	if err != nil {
+		// A connection that could not be established never reached the API;
+		// anything else may have been processed before it broke.
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return true
+		}
+		return idempotent
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode == http.StatusServiceUnavailable:
+		// the API refused the request without acting on it
+		return true
+	case resp.StatusCode >= 500:
+		return idempotent
+	}
+	return false
+}
+
+// backoff returns how long to wait before the given retry attempt.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait
+		}
+	}
+
+	wait := t.minWait
+	for i := 0; i < attempt && wait < t.maxWait; i++ {
+		wait *= 2
+	}
+	if wait > t.maxWait {
+		wait = t.maxWait
+	}
+	return wait
+}
+
+// parseRetryAfter reads a Retry-After header given either in seconds or as
+// an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	if at, err := http.ParseTime(v); err == nil {
+		wait := time.Until(at)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
@@ -0,0 +1,125 @@
+package ultradns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRetryTestClient(maxRetries int) *http.Client {
+	return &http.Client{
+		Transport: &retryTransport{
+			base:       http.DefaultTransport,
+			maxRetries: maxRetries,
+			minWait:    time.Millisecond,
+			maxWait:    10 * time.Millisecond,
+		},
+	}
+}
+
+// newFlakyServer answers the first `failures` requests with status and
+// every later one with 200.
+func newFlakyServer(failures int, status int, calls *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		if *calls <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func TestRetryTransportRetriesServerErrors(t *testing.T) {
+	calls := 0
+	server := newFlakyServer(2, http.StatusInternalServerError, &calls)
+	defer server.Close()
+
+	req, _ := http.NewRequest("PUT", server.URL+"/zones/example.com./rrsets/A/www", strings.NewReader(`{"ttl":300}`))
+	resp, err := newRetryTestClient(3).Do(req)
+	assert.Nil(t, err, true)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, true)
+	assert.Equal(t, 3, calls, true)
+}
+
+func TestRetryTransportGivesUp(t *testing.T) {
+	calls := 0
+	server := newFlakyServer(10, http.StatusBadGateway, &calls)
+	defer server.Close()
+
+	resp, err := newRetryTestClient(2).Get(server.URL + "/zones")
+	assert.Nil(t, err, true)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode, true)
+	assert.Equal(t, 3, calls, true)
+}
+
+func TestRetryTransportDoesNotRetryCreateOnServerError(t *testing.T) {
+	calls := 0
+	server := newFlakyServer(1, http.StatusInternalServerError, &calls)
+	defer server.Close()
+
+	resp, err := newRetryTestClient(3).Post(server.URL+"/zones/example.com./rrsets/A/www", "application/json", strings.NewReader(`{}`))
+	assert.Nil(t, err, true)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode, true)
+	assert.Equal(t, 1, calls, true)
+}
+
+func TestRetryTransportRetriesRateLimitedCreate(t *testing.T) {
+	calls := 0
+	server := newFlakyServer(1, http.StatusTooManyRequests, &calls)
+	defer server.Close()
+
+	resp, err := newRetryTestClient(3).Post(server.URL+"/zones/example.com./rrsets/A/www", "application/json", strings.NewReader(`{}`))
+	assert.Nil(t, err, true)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, true)
+	assert.Equal(t, 2, calls, true)
+}
+
+func TestRetryTransportRetriesRefusedConnection(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	_, err := newRetryTestClient(0).Post(url+"/zones", "application/json", strings.NewReader(`{}`))
+	assert.NotNil(t, err, true)
+
+	transport := &retryTransport{}
+	req, _ := http.NewRequest("POST", url+"/zones", strings.NewReader(`{}`))
+	_, err = http.DefaultTransport.RoundTrip(req)
+	assert.True(t, transport.shouldRetry(req, nil, err), true)
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := &retryTransport{
+		minWait: time.Second,
+		maxWait: 5 * time.Second,
+	}
+	assert.Equal(t, time.Second, transport.backoff(0, nil), true)
+	assert.Equal(t, 2*time.Second, transport.backoff(1, nil), true)
+	assert.Equal(t, 4*time.Second, transport.backoff(2, nil), true)
+	assert.Equal(t, 5*time.Second, transport.backoff(3, nil), true)
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "7")
+	assert.Equal(t, 7*time.Second, transport.backoff(0, resp), true)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	wait, ok := parseRetryAfter("120")
+	assert.True(t, ok, true)
+	assert.Equal(t, 2*time.Minute, wait, true)
+
+	_, ok = parseRetryAfter("")
+	assert.False(t, ok, true)
+
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok, true)
+
+	wait, ok = parseRetryAfter(time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+	assert.True(t, ok, true)
+	assert.Equal(t, time.Duration(0), wait, true)
+}
@@ -0,0 +1,78 @@
+package ultradns
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/ultradns/ultradns-sdk-go"
+)
+
+// taskTransport hands background tasks back to the caller. udnssdk polls a
+// 202 response itself with a fixed budget and never returns once the task
+// completes, so such responses are passed on as plain successes that keep
+// their X-Task-Id header and body. Resources then wait for the task with waitForTask.
+type taskTransport struct {
+	base http.RoundTripper
+}
+
+func (t *taskTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusAccepted || resp.Header.Get("X-Task-Id") == "" {
+		return resp, err
+	}
+
+	log.Printf("[DEBUG] %s %s started task %s", req.Method, req.URL.Path, resp.Header.Get("X-Task-Id"))
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	// Keep the body, which may identify what the task creates. udnssdk
+	// decodes it, so an empty one stands in as an empty object.
+	if len(bytes.TrimSpace(body)) == 0 {
+		body = []byte("{}")
+	}
+	resp.StatusCode = http.StatusOK
+	resp.Status = "200 OK"
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	return resp, nil
+}
+
+// waitForTask blocks until the background task started by resp, if any,
+// has finished. A task that fails, outlives timeout or is cancelled through
+// ctx is reported as an error.
+func waitForTask(ctx context.Context, client *udnssdk.Client, resp *http.Response, timeout time.Duration) error {
+	if resp == nil {
+		return nil
+	}
+	id := udnssdk.TaskID(resp.Header.Get("X-Task-Id"))
+	if id == "" {
+		return nil
+	}
+
+	log.Printf("[INFO] Waiting for task %s", id)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING", "IN_PROCESS"},
+		Target:  []string{"COMPLETE"},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			t, _, err := client.Tasks.Find(id)
+			if err != nil {
+				return nil, "", err
+			}
+			if t.TaskStatusCode == "ERROR" {
+				return t, t.TaskStatusCode, fmt.Errorf("task %s failed: %s", id, t.Message)
+			}
+			return t, t.TaskStatusCode, nil
+		},
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
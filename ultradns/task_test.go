@@ -0,0 +1,106 @@
+package ultradns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	udnssdk "github.com/ultradns/ultradns-sdk-go"
+)
+
+// newTaskTestClient returns a client against a server that answers every
+// rrset change with task "T1", whose status walks through statuses on each
+// poll.
+func newTaskTestClient(t *testing.T, statuses ...string) (*udnssdk.Client, *httptest.Server) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/tasks/T1") {
+			status := statuses[polls]
+			if polls < len(statuses)-1 {
+				polls++
+			}
+			fmt.Fprintf(w, `{"taskId":"T1","taskStatusCode":"%s","message":"Pool rollout %s"}`, status, strings.ToLower(status))
+			return
+		}
+		w.Header().Set("X-Task-Id", "T1")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+
+	client, err := udnssdk.NewClient("user", "pass", server.URL+"/")
+	assert.Nil(t, err, true)
+	client.HTTPClient = &http.Client{
+		Transport: &taskTransport{base: http.DefaultTransport},
+	}
+	return client, server
+}
+
+func TestWaitForTaskComplete(t *testing.T) {
+	client, server := newTaskTestClient(t, "PENDING", "IN_PROCESS", "COMPLETE")
+	defer server.Close()
+
+	k := udnssdk.RRSetKey{Zone: "example.com.", Type: "A", Name: "www"}
+	resp, err := client.RRSets.Create(k, udnssdk.RRSet{})
+	assert.Nil(t, err, true)
+	assert.Equal(t, "T1", resp.Header.Get("X-Task-Id"), true)
+
+	err = waitForTask(context.Background(), client, resp, time.Minute)
+	assert.Nil(t, err, true)
+}
+
+func TestWaitForTaskError(t *testing.T) {
+	client, server := newTaskTestClient(t, "PENDING", "ERROR")
+	defer server.Close()
+
+	k := udnssdk.RRSetKey{Zone: "example.com.", Type: "A", Name: "www"}
+	resp, err := client.RRSets.Delete(k)
+	assert.Nil(t, err, true)
+
+	err = waitForTask(context.Background(), client, resp, time.Minute)
+	assert.NotNil(t, err, true)
+	assert.Contains(t, err.Error(), "Pool rollout error", true)
+}
+
+func TestWaitForTaskTimeout(t *testing.T) {
+	client, server := newTaskTestClient(t, "PENDING")
+	defer server.Close()
+
+	k := udnssdk.RRSetKey{Zone: "example.com.", Type: "A", Name: "www"}
+	resp, err := client.RRSets.Update(k, udnssdk.RRSet{})
+	assert.Nil(t, err, true)
+
+	err = waitForTask(context.Background(), client, resp, 500*time.Millisecond)
+	assert.NotNil(t, err, true)
+}
+
+func TestTaskTransportKeepsBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Task-Id", "T1")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"guid":"0608485259D5AC50"}`)
+	}))
+	defer server.Close()
+
+	client, err := udnssdk.NewClient("user", "pass", server.URL+"/")
+	assert.Nil(t, err, true)
+	client.HTTPClient = &http.Client{
+		Transport: &taskTransport{base: http.DefaultTransport},
+	}
+
+	var created webForwardDTO
+	resp, err := client.Do("POST", "zones/example.com./webforwards", webForwardDTO{}, &created)
+	assert.Nil(t, err, true)
+	assert.Equal(t, "T1", resp.Header.Get("X-Task-Id"), true)
+	assert.Equal(t, "0608485259D5AC50", created.GUID, true)
+}
+
+func TestWaitForTaskWithoutTask(t *testing.T) {
+	assert.Nil(t, waitForTask(context.Background(), nil, nil, time.Minute), true)
+	assert.Nil(t, waitForTask(context.Background(), nil, &http.Response{Header: http.Header{}}, time.Minute), true)
+}
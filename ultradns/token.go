@@ -0,0 +1,135 @@
+package ultradns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ultradns/ultradns-sdk-go"
+	"golang.org/x/oauth2"
+)
+
+// tokenSource hands out UltraDNS access tokens. Tokens supplied by the user
+// carry no expiry, so besides the usual expiry check the token is refreshed
+// whenever the API has rejected it.
+type tokenSource struct {
+	mu    sync.Mutex
+	conf  *oauth2.Config
+	token *oauth2.Token
+
+	// httpClient, when set, is used to reach the token endpoint
+	httpClient *http.Client
+}
+
+func newTokenSource(accessToken, refreshToken, baseURL string) *tokenSource {
+	return &tokenSource{
+		conf: &oauth2.Config{
+			Endpoint: udnssdk.Endpoint(baseURL),
+		},
+		token: &oauth2.Token{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+			TokenType:    "Bearer",
+		},
+	}
+}
+
+// Token returns the current access token, refreshing it first when it is
+// missing or expired.
+func (s *tokenSource) Token() (*oauth2.Token, error) {
+	return s.tokenContext(context.Background())
+}
+
+// tokenContext is Token with a refresh that is cancelled along with ctx.
+func (s *tokenSource) tokenContext(ctx context.Context) (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Valid() {
+		return s.token, nil
+	}
+	if s.token.RefreshToken == "" {
+		return nil, errors.New("access token expired and no refresh_token is configured")
+	}
+
+	log.Printf("[INFO] Refreshing UltraDNS access token")
+	if s.httpClient != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, s.httpClient)
+	}
+	t, err := s.conf.TokenSource(ctx, &oauth2.Token{
+		RefreshToken: s.token.RefreshToken,
+	}).Token()
+	if err != nil {
+		return nil, fmt.Errorf("Error refreshing access token: %s", err)
+	}
+	s.token = t
+	return t, nil
+}
+
+// canRefresh reports whether a rejected token can be replaced.
+func (s *tokenSource) canRefresh() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token.RefreshToken != ""
+}
+
+// invalidate marks t as rejected so that the next call to Token refreshes
+// it. A token that has already been replaced by a concurrent request is
+// left alone.
+func (s *tokenSource) invalidate(t *oauth2.Token) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token.AccessToken == t.AccessToken {
+		s.token.Expiry = time.Now().Add(-time.Second)
+	}
+}
+
+// tokenTransport authorizes each request with the current access token and
+// replays it once with a refreshed token when the API answers 401. Token
+// refreshes are bound to the context of the request that needs them.
+type tokenTransport struct {
+	source *tokenSource
+	base   http.RoundTripper
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	tok, err := t.source.tokenContext(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.base.RoundTrip(authorizeRequest(req, tok))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || !t.source.canRefresh() {
+		return resp, err
+	}
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+
+	log.Printf("[DEBUG] UltraDNS rejected the access token, refreshing and retrying %s %s", req.Method, req.URL.Path)
+	resp.Body.Close()
+	t.source.invalidate(tok)
+	tok, err = t.source.tokenContext(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	retry := authorizeRequest(req, tok)
+	if req.GetBody != nil {
+		retry.Body, err = req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return t.base.RoundTrip(retry)
+}
+
+// authorizeRequest returns a copy of req carrying tok. RoundTrippers must not
+// modify the request they are given.
+func authorizeRequest(req *http.Request, tok *oauth2.Token) *http.Request {
+	r := req.Clone(req.Context())
+	tok.SetAuthHeader(r)
+	return r
+}
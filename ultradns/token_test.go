@@ -0,0 +1,127 @@
+package ultradns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTokenTestServer(t *testing.T, refreshes *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/authorization/token") {
+			r.ParseForm()
+			assert.Equal(t, "refresh_token", r.Form.Get("grant_type"), true)
+			assert.Equal(t, "refresh", r.Form.Get("refresh_token"), true)
+			*refreshes++
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"access_token":"fresh-%d","refresh_token":"refresh","token_type":"Bearer","expires_in":3600}`, *refreshes)
+			return
+		}
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer fresh-") {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"errorCode":60001,"errorMessage":"invalid_grant"}`)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	}))
+}
+
+func TestTokenTransportRefreshesRejectedToken(t *testing.T) {
+	refreshes := 0
+	server := newTokenTestServer(t, &refreshes)
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &tokenTransport{
+			source: newTokenSource("expired", "refresh", server.URL),
+			base:   http.DefaultTransport,
+		},
+	}
+
+	resp, err := client.Post(server.URL+"/zones", "application/json", strings.NewReader(`{}`))
+	assert.Nil(t, err, true)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, true)
+	assert.Equal(t, 1, refreshes, true)
+
+	// the refreshed token is reused for subsequent requests
+	resp, err = client.Get(server.URL + "/zones")
+	assert.Nil(t, err, true)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, true)
+	assert.Equal(t, 1, refreshes, true)
+}
+
+func TestTokenTransportRefreshTokenOnly(t *testing.T) {
+	refreshes := 0
+	server := newTokenTestServer(t, &refreshes)
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &tokenTransport{
+			source: newTokenSource("", "refresh", server.URL),
+			base:   http.DefaultTransport,
+		},
+	}
+
+	resp, err := client.Get(server.URL + "/zones")
+	assert.Nil(t, err, true)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, true)
+	assert.Equal(t, 1, refreshes, true)
+}
+
+func TestTokenTransportWithoutRefreshToken(t *testing.T) {
+	refreshes := 0
+	server := newTokenTestServer(t, &refreshes)
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &tokenTransport{
+			source: newTokenSource("expired", "", server.URL),
+			base:   http.DefaultTransport,
+		},
+	}
+
+	resp, err := client.Get(server.URL + "/zones")
+	assert.Nil(t, err, true)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, true)
+	assert.Equal(t, 0, refreshes, true)
+}
+
+func TestTokenTransportRefreshCancelled(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the token endpoint hangs until the test ends
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := &http.Client{
+		Transport: &tokenTransport{
+			source: newTokenSource("", "refresh", server.URL),
+			base:   http.DefaultTransport,
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, "GET", server.URL+"/zones", nil)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.Do(req)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		assert.NotNil(t, err, true)
+	case <-time.After(5 * time.Second):
+		t.Fatal("token refresh was not cancelled with the request")
+	}
+}
@@ -0,0 +1,86 @@
+package ultradns
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// udnssdk has no web forward support, so the webforwards endpoints are
+// called through the client's Do with the DTO below.
+
+// webForwardDTO is a web forward as the webforwards endpoints send and
+// receive it. The GUID is assigned by UltraDNS.
+type webForwardDTO struct {
+	GUID                  string `json:"guid,omitempty"`
+	RequestTo             string `json:"requestTo"`
+	DefaultRedirectTo     string `json:"defaultRedirectTo"`
+	DefaultForwardType    string `json:"defaultForwardType"`
+	QueryStringForwarding bool   `json:"queryStringForwarding"`
+	Advanced              bool   `json:"advanced"`
+}
+
+// webForwardsURI returns the URI of the web forwards of zone.
+func webForwardsURI(zone string) string {
+	return fmt.Sprintf("%s/webforwards", zoneURI(zone))
+}
+
+// webForwardURI returns the URI of the web forward with guid in zone.
+func webForwardURI(zone, guid string) string {
+	return fmt.Sprintf("%s/%s", webForwardsURI(zone), guid)
+}
+
+// getWebForward returns the web forward with guid in zone.
+func (c *Client) getWebForward(zone, guid string) (webForwardDTO, error) {
+	var wf webForwardDTO
+	_, err := c.Do("GET", webForwardURI(zone, guid), nil, &wf)
+	return wf, err
+}
+
+// createWebForward creates wf in zone and returns its GUID, taken from
+// the response or, failing that, from its Location. The GUID is empty if
+// neither names it; findWebForward looks the forward up once the create
+// has finished.
+func (c *Client) createWebForward(zone string, wf webForwardDTO) (string, *http.Response, error) {
+	var created webForwardDTO
+	resp, err := c.Do("POST", webForwardsURI(zone), wf, &created)
+	if err != nil {
+		return "", resp, err
+	}
+	guid := created.GUID
+	if guid == "" && resp != nil {
+		if loc := resp.Header.Get("Location"); loc != "" {
+			guid = path.Base(loc)
+		}
+	}
+	return guid, resp, nil
+}
+
+// findWebForward returns the GUID of the web forward for requestTo in
+// zone.
+func (c *Client) findWebForward(zone, requestTo string) (string, error) {
+	var list struct {
+		WebForwards []webForwardDTO `json:"webForwards"`
+	}
+	if _, err := c.Do("GET", webForwardsURI(zone), nil, &list); err != nil {
+		return "", err
+	}
+	for _, wf := range list.WebForwards {
+		if strings.EqualFold(wf.RequestTo, requestTo) && wf.GUID != "" {
+			return wf.GUID, nil
+		}
+	}
+	return "", fmt.Errorf("web forward for %s not found in zone %s", requestTo, zone)
+}
+
+// updateWebForward replaces the web forward with guid in zone by wf.
+func (c *Client) updateWebForward(zone, guid string, wf webForwardDTO) (*http.Response, error) {
+	var ignored interface{}
+	return c.Do("PUT", webForwardURI(zone, guid), wf, &ignored)
+}
+
+// deleteWebForward deletes the web forward with guid in zone.
+func (c *Client) deleteWebForward(zone, guid string) (*http.Response, error) {
+	return c.Do("DELETE", webForwardURI(zone, guid), nil, nil)
+}
@@ -0,0 +1,160 @@
+package ultradns
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ultradns/ultradns-sdk-go"
+)
+
+// udnssdk can only list zones, so the zones endpoints are called through
+// the client's Do with the DTOs below.
+
+// zoneDTO is a zone as the zones endpoints send and receive it. The
+// create infos are only sent; secondary zones come back with their
+// nameservers, notification address and transfer status instead, and alias
+// zones with the name of their original zone.
+type zoneDTO struct {
+	Properties          zoneProperties           `json:"properties"`
+	PrimaryCreateInfo   *primaryZoneCreateInfo   `json:"primaryCreateInfo,omitempty"`
+	SecondaryCreateInfo *secondaryZoneCreateInfo `json:"secondaryCreateInfo,omitempty"`
+	AliasCreateInfo     *aliasZoneCreateInfo     `json:"aliasCreateInfo,omitempty"`
+
+	PrimaryNameServers       *nameServerList        `json:"primaryNameServers,omitempty"`
+	NotificationEmailAddress string                 `json:"notificationEmailAddress,omitempty"`
+	TransferStatusDetails    *transferStatusDetails `json:"transferStatusDetails,omitempty"`
+	OriginalZoneName         string                 `json:"originalZoneName,omitempty"`
+}
+
+// zoneProperties describes any type of zone.
+type zoneProperties struct {
+	Name                 string `json:"name"`
+	AccountName          string `json:"accountName"`
+	Type                 string `json:"type"`
+	DNSSECStatus         string `json:"dnssecStatus,omitempty"`
+	Status               string `json:"status,omitempty"`
+	Owner                string `json:"owner,omitempty"`
+	ResourceRecordCount  int    `json:"resourceRecordCount,omitempty"`
+	LastModifiedDateTime string `json:"lastModifiedDateTime,omitempty"`
+}
+
+// primaryZoneCreateInfo says how the records of a new primary zone are
+// obtained.
+type primaryZoneCreateInfo struct {
+	ForceImport      bool           `json:"forceImport"`
+	CreateType       string         `json:"createType"`
+	OriginalZoneName string         `json:"originalZoneName,omitempty"`
+	NameServer       *nameServerDTO `json:"nameServer,omitempty"`
+}
+
+// secondaryZoneCreateInfo configures where a secondary zone is transferred
+// from. It is sent to create and to update the zone.
+type secondaryZoneCreateInfo struct {
+	PrimaryNameServers       nameServerList `json:"primaryNameServers"`
+	NotificationEmailAddress string         `json:"notificationEmailAddress"`
+}
+
+// aliasZoneCreateInfo names the zone an alias zone serves the records of.
+type aliasZoneCreateInfo struct {
+	OriginalZoneName string `json:"originalZoneName"`
+}
+
+// nameServerList holds up to three nameservers, keyed nameServerIp1 to
+// nameServerIp3.
+type nameServerList struct {
+	NameServerIPList map[string]nameServerDTO `json:"nameServerIpList"`
+}
+
+// transferStatusDetails reports on the transfers of a secondary zone.
+type transferStatusDetails struct {
+	LastRefresh              string `json:"lastRefresh,omitempty"`
+	NextRefresh              string `json:"nextRefresh,omitempty"`
+	LastRefreshStatus        string `json:"lastRefreshStatus,omitempty"`
+	LastRefreshStatusMessage string `json:"lastRefreshStatusMessage,omitempty"`
+}
+
+// nameServerDTO is a nameserver zone data is transferred from.
+type nameServerDTO struct {
+	IP            string `json:"ip"`
+	TSIGKey       string `json:"tsigKey,omitempty"`
+	TSIGKeyValue  string `json:"tsigKeyValue,omitempty"`
+	TSIGAlgorithm string `json:"tsigAlgorithm,omitempty"`
+}
+
+// zoneURI returns the URI of a zone, escaping reverse zones the way
+// udnssdk does.
+func zoneURI(name string) string {
+	return fmt.Sprintf("zones/%s", strings.Replace(name, "/", "%2F", -1))
+}
+
+// getZone returns the zone called name.
+func (c *Client) getZone(name string) (zoneDTO, error) {
+	var z zoneDTO
+	_, err := c.Do("GET", zoneURI(name), nil, &z)
+	return z, err
+}
+
+// createZone creates z. The response may carry a background task to wait
+// for.
+func (c *Client) createZone(z zoneDTO) (*http.Response, error) {
+	var ignored interface{}
+	return c.Do("POST", "zones", z, &ignored)
+}
+
+// updateZone replaces the configuration of the zone z names.
+func (c *Client) updateZone(z zoneDTO) (*http.Response, error) {
+	var ignored interface{}
+	return c.Do("PUT", zoneURI(z.Properties.Name), z, &ignored)
+}
+
+// deleteZone deletes the zone called name, with all its records.
+func (c *Client) deleteZone(name string) (*http.Response, error) {
+	return c.Do("DELETE", zoneURI(name), nil, nil)
+}
+
+// nameServerKey returns the key of the i-th nameserver, counting from 0, in
+// a nameServerList.
+func nameServerKey(i int) string {
+	return fmt.Sprintf("nameServerIp%d", i+1)
+}
+
+// zoneNameservers returns the nameservers of the NS records at the apex of
+// zone, which UltraDNS assigns when the zone is created.
+func (c *Client) zoneNameservers(zone string) ([]string, error) {
+	rrsets, err := c.RRSets.Select(udnssdk.RRSetKey{Zone: zone, Type: "NS", Name: zone})
+	if err != nil {
+		if isNotFound(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	for _, rrset := range rrsets {
+		return rrset.RData, nil
+	}
+	return []string{}, nil
+}
+
+// isZoneNotFound reports whether err says the zone does not exist.
+func isZoneNotFound(err error) bool {
+	return hasErrorCode(err, 1801)
+}
+
+// isNotFound reports whether err says the requested data does not exist.
+func isNotFound(err error) bool {
+	return hasErrorCode(err, 70002)
+}
+
+func hasErrorCode(err error, code int) bool {
+	switch e := err.(type) {
+	case *udnssdk.ErrorResponseList:
+		for _, r := range e.Responses {
+			if r.ErrorCode == code {
+				return true
+			}
+		}
+	case udnssdk.ErrorResponse:
+		return e.ErrorCode == code
+	}
+	return false
+}